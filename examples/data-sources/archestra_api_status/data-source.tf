@@ -0,0 +1,14 @@
+# Check the API health and how close the provider is to the rate limit
+data "archestra_api_status" "current" {}
+
+output "rate_limit_remaining" {
+  value = data.archestra_api_status.current.rate_limit_remaining
+}
+
+# Fail the plan early when the rate limit is nearly exhausted
+check "rate_limit_headroom" {
+  assert {
+    condition     = coalesce(data.archestra_api_status.current.rate_limit_remaining, 1000) > 100
+    error_message = "Fewer than 100 API requests remain in the current rate limit window."
+  }
+}
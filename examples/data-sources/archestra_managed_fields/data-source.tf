@@ -0,0 +1,16 @@
+# List the fields managed by each Archestra resource
+data "archestra_managed_fields" "all" {}
+
+output "mcp_server_managed_fields" {
+  value = one([
+    for r in data.archestra_managed_fields.all.resources : r.fields
+    if r.type_name == "archestra_mcp_server"
+  ])
+}
+
+# Fields of each Archestra object that must be managed outside Terraform
+output "ignored_fields" {
+  value = {
+    for r in data.archestra_managed_fields.all.resources : r.type_name => r.ignored_fields
+  }
+}
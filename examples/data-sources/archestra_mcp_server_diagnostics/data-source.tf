@@ -0,0 +1,13 @@
+# Inspect an MCP server installation that fails to start
+data "archestra_mcp_server_diagnostics" "filesystem" {
+  mcp_server_installation_id = archestra_mcp_server_installation.filesystem.id
+  log_lines                  = 50
+}
+
+output "filesystem_status" {
+  value = data.archestra_mcp_server_diagnostics.filesystem.status
+}
+
+output "filesystem_last_error" {
+  value = data.archestra_mcp_server_diagnostics.filesystem.last_error
+}
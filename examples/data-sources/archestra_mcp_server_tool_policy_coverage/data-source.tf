@@ -0,0 +1,8 @@
+# Find tools of an MCP server that are not governed by any tool invocation policy
+data "archestra_mcp_server_tool_policy_coverage" "filesystem" {
+  mcp_server_id = archestra_mcp_server_installation.filesystem.id
+}
+
+output "ungoverned_filesystem_tools" {
+  value = data.archestra_mcp_server_tool_policy_coverage.filesystem.uncovered_tools
+}
@@ -0,0 +1,6 @@
+# Look up the organization the provider is authenticated against
+data "archestra_organization" "current" {}
+
+output "organization_id" {
+  value = data.archestra_organization.current.id
+}
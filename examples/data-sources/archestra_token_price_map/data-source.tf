@@ -0,0 +1,10 @@
+# Look up token prices by provider and model
+data "archestra_token_price_map" "all" {}
+
+locals {
+  prices = data.archestra_token_price_map.all.prices
+}
+
+output "gpt_4o_input_price" {
+  value = local.prices["openai"]["gpt-4o"].input
+}
@@ -13,3 +13,9 @@ output "gpt4o_pricing" {
     if tp.model == "gpt-4o"
   ]
 }
+
+# Example: Export token prices as a CSV file
+resource "local_file" "token_prices_csv" {
+  filename = "${path.module}/token_prices.csv"
+  content  = data.archestra_token_prices.all.csv
+}
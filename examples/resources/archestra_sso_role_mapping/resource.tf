@@ -0,0 +1,18 @@
+# Manage the role mapping rules of an existing SSO provider. The SSO provider
+# itself (issuer, client credentials, etc.) can be owned by another team.
+resource "archestra_sso_role_mapping" "okta" {
+  sso_provider_id = var.okta_sso_provider_id
+  default_role    = "member"
+  strict_mode     = false
+
+  rules = [
+    {
+      expression = "'platform-admins' in groups"
+      role       = "admin"
+    },
+    {
+      expression = "'engineering' in groups"
+      role       = "editor"
+    },
+  ]
+}
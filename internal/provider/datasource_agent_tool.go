@@ -18,7 +18,8 @@ func NewAgentToolDataSource() datasource.DataSource {
 }
 
 type AgentToolDataSource struct {
-	client *client.ClientWithResponses
+	client   *client.ClientWithResponses
+	pageSize int
 }
 
 type AgentToolDataSourceModel struct {
@@ -78,16 +79,17 @@ func (d *AgentToolDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraDataSourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraDataSourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
+	d.pageSize = data.PageSize
 }
 
 func (d *AgentToolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -121,39 +123,33 @@ func (d *AgentToolDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	// Use max allowed limit to get all tools for this agent in one request
-	// (built-in tools are typically <30, so 100 is more than enough)
-	limit := 100
-
 	result, found, err := RetryUntilFound(ctx, retryConfig, func() (agentToolResult, bool, error) {
 		// Get agent tools filtered by agent ID (more efficient than fetching all)
-		toolsResp, err := d.client.GetAllAgentToolsWithResponse(ctx, &client.GetAllAgentToolsParams{
-			AgentId: &agentUUID,
-			Limit:   &limit,
+		var result agentToolResult
+		found := false
+		err := forEachAgentToolsPage(ctx, d.client, client.GetAllAgentToolsParams{AgentId: &agentUUID}, d.pageSize, func(page *client.GetAllAgentToolsResponse) bool {
+			// Find the specific tool by name
+			for i := range page.JSON200.Data {
+				agentTool := &page.JSON200.Data[i]
+				if agentTool.Tool.Name == targetToolName {
+					result = agentToolResult{
+						ID:                                   agentTool.Id.String(),
+						ToolID:                               agentTool.Tool.Id,
+						AllowUsageWhenUntrustedDataIsPresent: agentTool.AllowUsageWhenUntrustedDataIsPresent,
+						ToolResultTreatment:                  string(agentTool.ToolResultTreatment),
+						ResponseModifierTemplate:             agentTool.ResponseModifierTemplate,
+					}
+					found = true
+					return false
+				}
+			}
+			return true
 		})
 		if err != nil {
 			return agentToolResult{}, false, fmt.Errorf("unable to read agent tools: %w", err)
 		}
 
-		if toolsResp.JSON200 == nil {
-			return agentToolResult{}, false, fmt.Errorf("expected 200 OK, got status %d", toolsResp.StatusCode())
-		}
-
-		// Find the specific tool by name
-		for i := range toolsResp.JSON200.Data {
-			agentTool := &toolsResp.JSON200.Data[i]
-			if agentTool.Tool.Name == targetToolName {
-				return agentToolResult{
-					ID:                                   agentTool.Id.String(),
-					ToolID:                               agentTool.Tool.Id,
-					AllowUsageWhenUntrustedDataIsPresent: agentTool.AllowUsageWhenUntrustedDataIsPresent,
-					ToolResultTreatment:                  string(agentTool.ToolResultTreatment),
-					ResponseModifierTemplate:             agentTool.ResponseModifierTemplate,
-				}, true, nil
-			}
-		}
-
-		return agentToolResult{}, false, nil
+		return result, found, nil
 	})
 
 	if err != nil {
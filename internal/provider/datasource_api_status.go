@@ -0,0 +1,113 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &APIStatusDataSource{}
+
+func NewAPIStatusDataSource() datasource.DataSource {
+	return &APIStatusDataSource{}
+}
+
+type APIStatusDataSource struct {
+	client     *client.ClientWithResponses
+	rateLimits *rateLimitTracker
+}
+
+type APIStatusDataSourceModel struct {
+	Status             types.String `tfsdk:"status"`
+	Version            types.String `tfsdk:"version"`
+	RateLimitRemaining types.Int64  `tfsdk:"rate_limit_remaining"`
+	RateLimitReset     types.String `tfsdk:"rate_limit_reset"`
+}
+
+func (d *APIStatusDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_status"
+}
+
+func (d *APIStatusDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the health of the Archestra API and the latest rate limit values it reported. " +
+			"The rate limit values come from the `X-RateLimit-Remaining` and `X-RateLimit-Reset` headers of the most recent " +
+			"API response that carried them, which helps spot when a large apply is close to the limit.",
+
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The health status reported by the API",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "The version of the Archestra API",
+				Computed:            true,
+			},
+			"rate_limit_remaining": schema.Int64Attribute{
+				MarkdownDescription: "Requests remaining in the current rate limit window, or null if the API has not reported it",
+				Computed:            true,
+			},
+			"rate_limit_reset": schema.StringAttribute{
+				MarkdownDescription: "When the current rate limit window resets, as reported by the API, or null if the API has not reported it",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *APIStatusDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ArchestraDataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ArchestraDataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.Client
+	d.rateLimits = data.RateLimits
+}
+
+func (d *APIStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data APIStatusDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := d.client.GetHealthWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read API health, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	data.Status = types.StringValue(apiResp.JSON200.Status)
+	data.Version = types.StringValue(apiResp.JSON200.Version)
+
+	var rateLimits rateLimitStatus
+	if d.rateLimits != nil {
+		rateLimits = d.rateLimits.status()
+	}
+	data.RateLimitRemaining = types.Int64PointerValue(rateLimits.Remaining)
+	data.RateLimitReset = types.StringPointerValue(rateLimits.Reset)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,207 @@
+package provider
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &ManagedFieldsDataSource{}
+
+func NewManagedFieldsDataSource() datasource.DataSource {
+	return &ManagedFieldsDataSource{}
+}
+
+// ManagedFieldsDataSource reports which fields each resource of this provider
+// manages. It is built from the resource schemas at runtime, so it needs no
+// API client.
+type ManagedFieldsDataSource struct {
+	providerTypeName string
+}
+
+type ManagedFieldsResourceModel struct {
+	TypeName       types.String   `tfsdk:"type_name"`
+	Fields         []types.String `tfsdk:"fields"`
+	ProviderFields []types.String `tfsdk:"provider_fields"`
+	IgnoredFields  []types.String `tfsdk:"ignored_fields"`
+}
+
+// managedFieldsCoverage lists, per resource type name without the provider
+// prefix, what the resource schemas alone cannot tell: attributes that only
+// steer the provider and are never stored on the Archestra object, and fields
+// of the Archestra object that the resource does not map. Timestamps such as
+// createdAt and updatedAt are left out of the ignored fields.
+var managedFieldsCoverage = map[string]struct {
+	providerFields []string
+	ignoredFields  []string
+}{
+	"agent": {
+		ignoredFields: []string{"considerContextUntrusted", "isDefault", "isDemo", "teams", "tools"},
+	},
+	"chat_llm_provider_api_key": {
+		providerFields: []string{"adopt_existing"},
+		ignoredFields:  []string{"organizationId", "profiles", "secretId"},
+	},
+	"limit": {
+		ignoredFields: []string{"lastCleanup"},
+	},
+	"mcp_server": {
+		providerFields: []string{"environment_ownership", "read_after_create_retries"},
+		ignoredFields: []string{
+			"clientSecretId", "instructions", "localConfigSecretId", "oauthConfig", "repository",
+			"requiresAuth", "serverType", "serverUrl", "userConfig", "version",
+		},
+	},
+	"mcp_server_installation": {
+		providerFields: []string{"redeploy_trigger"},
+		ignoredFields: []string{
+			"ownerEmail", "ownerId", "reinstallRequired", "secretId", "secretStorageType",
+			"serverType", "teamDetails", "teamId", "userDetails", "users",
+		},
+	},
+	"organization_settings": {
+		ignoredFields: []string{"metadata", "name", "slug"},
+	},
+	"sso_role_mapping": {
+		ignoredFields: []string{
+			"domain", "domainVerified", "issuer", "oidcConfig", "organizationId",
+			"providerId", "samlConfig", "teamSyncConfig", "userId",
+		},
+	},
+	"team": {
+		ignoredFields: []string{"convertToolResultsToToon"},
+	},
+	"token_price": {
+		providerFields: []string{"adopt_existing"},
+	},
+}
+
+type ManagedFieldsDataSourceModel struct {
+	Resources []ManagedFieldsResourceModel `tfsdk:"resources"`
+}
+
+func (d *ManagedFieldsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_managed_fields"
+	d.providerTypeName = req.ProviderTypeName
+}
+
+func (d *ManagedFieldsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports which fields of each Archestra object are managed by this provider's resources. " +
+			"Fields listed in `ignored_fields` are not mapped by the provider and must be managed elsewhere.",
+
+		Attributes: map[string]schema.Attribute{
+			"resources": schema.ListNestedAttribute{
+				MarkdownDescription: "Resources registered by the provider, sorted by type name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type_name": schema.StringAttribute{
+							MarkdownDescription: "The resource type name (e.g. `archestra_agent`)",
+							Computed:            true,
+						},
+						"fields": schema.ListAttribute{
+							MarkdownDescription: "Sorted attribute paths managed by the resource. Nested attributes are joined with `.` (e.g. `local_config.command`).",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"provider_fields": schema.ListAttribute{
+							MarkdownDescription: "Sorted attributes that only control how the provider behaves (e.g. `adopt_existing`) and are never stored on the Archestra object",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"ignored_fields": schema.ListAttribute{
+							MarkdownDescription: "Sorted fields of the Archestra object, as named by the API, that the resource does not map. Timestamps are not listed.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ManagedFieldsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data ManagedFieldsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resources := (&ArchestraProvider{}).Resources(ctx)
+	data.Resources = make([]ManagedFieldsResourceModel, 0, len(resources))
+
+	for _, newResource := range resources {
+		r := newResource()
+
+		metadataResp := resource.MetadataResponse{}
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: d.providerTypeName}, &metadataResp)
+
+		schemaResp := resource.SchemaResponse{}
+		r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+		resp.Diagnostics.Append(schemaResp.Diagnostics...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		coverage := managedFieldsCoverage[strings.TrimPrefix(metadataResp.TypeName, d.providerTypeName+"_")]
+
+		var fields []string
+		for name, attribute := range schemaResp.Schema.Attributes {
+			if slices.Contains(coverage.providerFields, name) {
+				continue
+			}
+			fields = append(fields, managedFieldPaths(name, attribute)...)
+		}
+
+		data.Resources = append(data.Resources, ManagedFieldsResourceModel{
+			TypeName:       types.StringValue(metadataResp.TypeName),
+			Fields:         sortedStringValues(fields),
+			ProviderFields: sortedStringValues(coverage.providerFields),
+			IgnoredFields:  sortedStringValues(coverage.ignoredFields),
+		})
+	}
+
+	sort.Slice(data.Resources, func(i, j int) bool {
+		return data.Resources[i].TypeName.ValueString() < data.Resources[j].TypeName.ValueString()
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// managedFieldPaths returns the attribute path for name, expanded into the
+// paths of its children when the attribute is nested.
+func managedFieldPaths(name string, attribute any) []string {
+	nested, ok := attribute.(resourceschema.NestedAttribute)
+	if !ok {
+		return []string{name}
+	}
+
+	var paths []string
+	for childName, child := range nested.GetNestedObject().GetAttributes() {
+		paths = append(paths, managedFieldPaths(name+"."+childName, child)...)
+	}
+
+	return paths
+}
+
+// sortedStringValues returns values sorted as a non-nil list of strings.
+func sortedStringValues(values []string) []types.String {
+	sorted := slices.Sorted(slices.Values(values))
+
+	result := make([]types.String, len(sorted))
+	for i, value := range sorted {
+		result[i] = types.StringValue(value)
+	}
+
+	return result
+}
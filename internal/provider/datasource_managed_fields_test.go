@@ -0,0 +1,144 @@
+package provider
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+// readManagedFields reads the managed fields data source for a provider
+// registered as providerTypeName.
+func readManagedFields(t *testing.T, providerTypeName string) map[string]ManagedFieldsResourceModel {
+	t.Helper()
+
+	d := &ManagedFieldsDataSource{}
+	d.Metadata(t.Context(), datasource.MetadataRequest{ProviderTypeName: providerTypeName}, &datasource.MetadataResponse{})
+
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(t.Context(), datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	raw := tftypes.NewValue(objectType, map[string]tftypes.Value{
+		"resources": tftypes.NewValue(objectType.AttributeTypes["resources"], nil),
+	})
+
+	resp := datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw}}
+	d.Read(t.Context(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var data ManagedFieldsDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(t.Context(), &data)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	resources := make(map[string]ManagedFieldsResourceModel, len(data.Resources))
+	for _, r := range data.Resources {
+		resources[r.TypeName.ValueString()] = r
+	}
+
+	return resources
+}
+
+func TestManagedFieldsDataSource_SplitsProviderFields(t *testing.T) {
+	resources := readManagedFields(t, "archestra")
+
+	mcpServer, ok := resources["archestra_mcp_server"]
+	if !ok {
+		t.Fatalf("Expected archestra_mcp_server to be reported, got %v", resources)
+	}
+	if !slices.Contains(mcpServer.Fields, types.StringValue("local_config.command")) {
+		t.Errorf("Expected local_config.command to be managed, got %v", mcpServer.Fields)
+	}
+	if slices.Contains(mcpServer.Fields, types.StringValue("read_after_create_retries")) {
+		t.Errorf("Expected read_after_create_retries not to be reported as managed, got %v", mcpServer.Fields)
+	}
+	if !slices.Equal(mcpServer.ProviderFields, []types.String{types.StringValue("environment_ownership"), types.StringValue("read_after_create_retries")}) {
+		t.Errorf("Unexpected provider fields %v", mcpServer.ProviderFields)
+	}
+	if !slices.Contains(mcpServer.IgnoredFields, types.StringValue("oauthConfig")) {
+		t.Errorf("Expected oauthConfig to be reported as ignored, got %v", mcpServer.IgnoredFields)
+	}
+
+	if policy := resources["archestra_trusted_data_policy"]; policy.ProviderFields == nil || len(policy.IgnoredFields) != 0 {
+		t.Errorf("Expected empty provider and ignored fields, got %v", policy)
+	}
+}
+
+func TestManagedFieldsDataSource_UsesProviderTypeName(t *testing.T) {
+	resources := readManagedFields(t, "custom")
+
+	tokenPrice, ok := resources["custom_token_price"]
+	if !ok {
+		t.Fatalf("Expected custom_token_price to be reported, got %v", resources)
+	}
+	if !slices.Equal(tokenPrice.ProviderFields, []types.String{types.StringValue("adopt_existing")}) {
+		t.Errorf("Expected adopt_existing to be a provider field, got %v", tokenPrice.ProviderFields)
+	}
+}
+
+func TestManagedFieldsCoverage_MatchesSchemas(t *testing.T) {
+	schemas := map[string]map[string]bool{}
+	for _, newResource := range (&ArchestraProvider{}).Resources(t.Context()) {
+		r := newResource()
+
+		metadataResp := frameworkresource.MetadataResponse{}
+		r.Metadata(t.Context(), frameworkresource.MetadataRequest{}, &metadataResp)
+
+		schemaResp := frameworkresource.SchemaResponse{}
+		r.Schema(t.Context(), frameworkresource.SchemaRequest{}, &schemaResp)
+
+		attributes := map[string]bool{}
+		for name := range schemaResp.Schema.Attributes {
+			attributes[name] = true
+		}
+		schemas[strings.TrimPrefix(metadataResp.TypeName, "_")] = attributes
+	}
+
+	for typeName, coverage := range managedFieldsCoverage {
+		attributes, ok := schemas[typeName]
+		if !ok {
+			t.Errorf("Coverage listed for unregistered resource %q", typeName)
+			continue
+		}
+		for _, field := range coverage.providerFields {
+			if !attributes[field] {
+				t.Errorf("Provider field %q is not an attribute of %q", field, typeName)
+			}
+		}
+	}
+}
+
+func TestAccManagedFieldsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: `data "archestra_managed_fields" "all" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.archestra_managed_fields.all", "resources.*", map[string]string{
+						"type_name": "archestra_agent",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.archestra_managed_fields.all", "resources.*", map[string]string{
+						"type_name": "archestra_token_price",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.archestra_managed_fields.all", "resources.*", map[string]string{
+						"type_name": "archestra_mcp_server",
+					}),
+					resource.TestCheckTypeSetElemAttr("data.archestra_managed_fields.all", "resources.*.fields.*", "local_config.command"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// defaultMCPServerDiagnosticsLogLines is the number of log lines fetched when
+// log_lines is not configured.
+const defaultMCPServerDiagnosticsLogLines = 100
+
+var _ datasource.DataSource = &MCPServerDiagnosticsDataSource{}
+
+func NewMCPServerDiagnosticsDataSource() datasource.DataSource {
+	return &MCPServerDiagnosticsDataSource{}
+}
+
+type MCPServerDiagnosticsDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type MCPServerDiagnosticsDataSourceModel struct {
+	MCPServerInstallationID types.String `tfsdk:"mcp_server_installation_id"`
+	LogLines                types.Int64  `tfsdk:"log_lines"`
+	Status                  types.String `tfsdk:"status"`
+	LastError               types.String `tfsdk:"last_error"`
+	ContainerName           types.String `tfsdk:"container_name"`
+	Namespace               types.String `tfsdk:"namespace"`
+	Logs                    types.String `tfsdk:"logs"`
+}
+
+func (d *MCPServerDiagnosticsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mcp_server_diagnostics"
+}
+
+func (d *MCPServerDiagnosticsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the current installation status, last installation error and recent logs of an installed MCP server. " +
+			"Useful for troubleshooting a server that fails to start without leaving the Terraform workflow. " +
+			"The Archestra API does not keep a history of status transitions, so only the current status is reported.",
+
+		Attributes: map[string]schema.Attribute{
+			"mcp_server_installation_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the MCP server installation (`archestra_mcp_server_installation.id`)",
+				Required:            true,
+			},
+			"log_lines": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of recent log lines to fetch, at least 1. Defaults to %d.", defaultMCPServerDiagnosticsLogLines),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The current local installation status reported by the orchestrator",
+				Computed:            true,
+			},
+			"last_error": schema.StringAttribute{
+				MarkdownDescription: "The last installation error reported by the orchestrator, or null if there is none",
+				Computed:            true,
+			},
+			"container_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the container running the MCP server, or null if no logs are available",
+				Computed:            true,
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the container running the MCP server, or null if no logs are available",
+				Computed:            true,
+			},
+			"logs": schema.StringAttribute{
+				MarkdownDescription: "Recent logs of the MCP server, or null if the server has no logs (for example, it is not running in the orchestrator)",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *MCPServerDiagnosticsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ArchestraDataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ArchestraDataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *MCPServerDiagnosticsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MCPServerDiagnosticsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, err := uuid.Parse(data.MCPServerInstallationID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse MCP server installation ID: %s", err))
+		return
+	}
+
+	statusResp, err := d.client.GetMcpServerInstallationStatusWithResponse(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read MCP server installation status, got error: %s", err))
+		return
+	}
+
+	if statusResp.JSON404 != nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("MCP server installation %s not found", serverID))
+		return
+	}
+
+	if statusResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", statusResp.StatusCode()),
+		)
+		return
+	}
+
+	data.Status = types.StringValue(string(statusResp.JSON200.LocalInstallationStatus))
+	if statusResp.JSON200.LocalInstallationError != nil {
+		data.LastError = types.StringValue(*statusResp.JSON200.LocalInstallationError)
+	} else {
+		data.LastError = types.StringNull()
+	}
+
+	lines := float32(defaultMCPServerDiagnosticsLogLines)
+	if !data.LogLines.IsNull() {
+		lines = float32(data.LogLines.ValueInt64())
+	}
+
+	logsResp, err := d.client.GetMcpServerLogsWithResponse(ctx, serverID, &client.GetMcpServerLogsParams{
+		Lines: &lines,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read MCP server logs, got error: %s", err))
+		return
+	}
+
+	// Servers that are not running in the orchestrator (or have not started
+	// yet) have no logs; report that as null rather than failing the read.
+	if logsResp.JSON200 != nil {
+		data.ContainerName = types.StringValue(logsResp.JSON200.ContainerName)
+		data.Namespace = types.StringValue(logsResp.JSON200.Namespace)
+		data.Logs = types.StringValue(logsResp.JSON200.Logs)
+	} else {
+		data.ContainerName = types.StringNull()
+		data.Namespace = types.StringNull()
+		data.Logs = types.StringNull()
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
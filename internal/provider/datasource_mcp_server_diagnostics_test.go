@@ -0,0 +1,136 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+const testMCPServerInstallationID = "44444444-4444-4444-4444-444444444444"
+
+// readMCPServerDiagnostics reads the diagnostics data source for the test
+// installation against a server answering with handler.
+func readMCPServerDiagnostics(t *testing.T, handler http.HandlerFunc) (MCPServerDiagnosticsDataSourceModel, *datasource.ReadResponse) {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	d := &MCPServerDiagnosticsDataSource{client: apiClient}
+	schemaResp := datasource.SchemaResponse{}
+	d.Schema(t.Context(), datasource.SchemaRequest{}, &schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+	}
+	values["mcp_server_installation_id"] = tftypes.NewValue(tftypes.String, testMCPServerInstallationID)
+	raw := tftypes.NewValue(objectType, values)
+
+	resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: raw}}
+	d.Read(t.Context(), datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: raw}}, resp)
+
+	var data MCPServerDiagnosticsDataSourceModel
+	if !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(resp.State.Get(t.Context(), &data)...)
+	}
+
+	return data, resp
+}
+
+func TestMCPServerDiagnosticsRead(t *testing.T) {
+	var lines string
+	data, resp := readMCPServerDiagnostics(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/mcp_server/" + testMCPServerInstallationID + "/installation-status":
+			_, _ = w.Write([]byte(`{"localInstallationStatus":"error","localInstallationError":"image pull failed"}`))
+		case "/api/mcp_server/" + testMCPServerInstallationID + "/logs":
+			lines = r.URL.Query().Get("lines")
+			_, _ = w.Write([]byte(`{"command":"kubectl logs","containerName":"mcp-filesystem","namespace":"archestra","logs":"starting"}`))
+		default:
+			t.Errorf("Unexpected request path %s", r.URL.Path)
+		}
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if data.Status.ValueString() != "error" || data.LastError.ValueString() != "image pull failed" {
+		t.Errorf("Expected the installation status to be mapped, got %v", data)
+	}
+	if data.ContainerName.ValueString() != "mcp-filesystem" || data.Namespace.ValueString() != "archestra" || data.Logs.ValueString() != "starting" {
+		t.Errorf("Expected the logs to be mapped, got %v", data)
+	}
+	if lines != "100" {
+		t.Errorf("Expected the default of 100 log lines to be requested, got %q", lines)
+	}
+}
+
+func TestMCPServerDiagnosticsRead_NoLogs(t *testing.T) {
+	data, resp := readMCPServerDiagnostics(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/api/mcp_server/"+testMCPServerInstallationID+"/logs" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"container not found","type":"not_found"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"localInstallationStatus":"pending","localInstallationError":null}`))
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if data.Status.ValueString() != "pending" || !data.LastError.IsNull() {
+		t.Errorf("Expected a pending status without error, got %v", data)
+	}
+	if !data.Logs.IsNull() || !data.ContainerName.IsNull() || !data.Namespace.IsNull() {
+		t.Errorf("Expected null logs for a server without logs, got %v", data)
+	}
+}
+
+func TestMCPServerDiagnosticsRead_NotFound(t *testing.T) {
+	_, resp := readMCPServerDiagnostics(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		_, _ = w.Write([]byte(`{"error":{"message":"not found","type":"not_found"}}`))
+	})
+
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Not Found" {
+		t.Errorf("Expected a Not Found error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestAccMCPServerDiagnosticsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Read testing
+			{
+				Config: testAccMCPServerInstallationResourceConfig("test-diagnostics-installation") + `
+data "archestra_mcp_server_diagnostics" "test" {
+  mcp_server_installation_id = archestra_mcp_server_installation.test.id
+  log_lines                  = 20
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.archestra_mcp_server_diagnostics.test", "mcp_server_installation_id", "archestra_mcp_server_installation.test", "id"),
+					resource.TestCheckResourceAttrSet("data.archestra_mcp_server_diagnostics.test", "status"),
+				),
+			},
+		},
+	})
+}
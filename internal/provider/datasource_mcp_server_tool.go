@@ -62,16 +62,16 @@ func (d *MCPServerToolDataSource) Configure(ctx context.Context, req datasource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraDataSourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraDataSourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 func (d *MCPServerToolDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
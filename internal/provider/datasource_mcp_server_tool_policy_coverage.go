@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &MCPServerToolPolicyCoverageDataSource{}
+
+func NewMCPServerToolPolicyCoverageDataSource() datasource.DataSource {
+	return &MCPServerToolPolicyCoverageDataSource{}
+}
+
+type MCPServerToolPolicyCoverageDataSource struct {
+	client   *client.ClientWithResponses
+	pageSize int
+}
+
+type ToolPolicyCoverageModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	PolicyCount types.Int64  `tfsdk:"policy_count"`
+	Covered     types.Bool   `tfsdk:"covered"`
+}
+
+type MCPServerToolPolicyCoverageDataSourceModel struct {
+	MCPServerID    types.String              `tfsdk:"mcp_server_id"`
+	Tools          []ToolPolicyCoverageModel `tfsdk:"tools"`
+	CoveredTools   []types.String            `tfsdk:"covered_tools"`
+	UncoveredTools []types.String            `tfsdk:"uncovered_tools"`
+}
+
+// toolRef identifies a tool provided by an MCP server.
+type toolRef struct {
+	ID   string
+	Name string
+}
+
+func (d *MCPServerToolPolicyCoverageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mcp_server_tool_policy_coverage"
+}
+
+func (d *MCPServerToolPolicyCoverageDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports which tools of an MCP server are covered by tool invocation policies. " +
+			"A tool is covered when at least one of its agent assignments has a tool invocation policy. " +
+			"This data source is useful for finding ungoverned tools.",
+
+		Attributes: map[string]schema.Attribute{
+			"mcp_server_id": schema.StringAttribute{
+				MarkdownDescription: "The MCP server ID",
+				Required:            true,
+			},
+			"tools": schema.ListNestedAttribute{
+				MarkdownDescription: "Policy coverage of each tool of the MCP server, sorted by name",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "Tool identifier",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the tool",
+							Computed:            true,
+						},
+						"policy_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of tool invocation policies across all agent assignments of the tool",
+							Computed:            true,
+						},
+						"covered": schema.BoolAttribute{
+							MarkdownDescription: "Whether the tool is covered by at least one tool invocation policy",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"covered_tools": schema.ListAttribute{
+				MarkdownDescription: "Sorted names of the tools covered by at least one tool invocation policy",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"uncovered_tools": schema.ListAttribute{
+				MarkdownDescription: "Sorted names of the tools not covered by any tool invocation policy",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *MCPServerToolPolicyCoverageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ArchestraDataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ArchestraDataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.Client
+	d.pageSize = data.PageSize
+}
+
+func (d *MCPServerToolPolicyCoverageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data MCPServerToolPolicyCoverageDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, err := uuid.Parse(data.MCPServerID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse MCP server ID: %s", err))
+		return
+	}
+
+	// Tools provided by the MCP server
+	toolsResp, err := d.client.GetMcpServerToolsWithResponse(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read MCP server tools, got error: %s", err))
+		return
+	}
+
+	if toolsResp.JSON404 != nil {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("MCP server %s not found", serverID))
+		return
+	}
+
+	if toolsResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", toolsResp.StatusCode()))
+		return
+	}
+
+	tools := make([]toolRef, len(*toolsResp.JSON200))
+	serverTools := make(map[string]bool, len(tools))
+	for i, tool := range *toolsResp.JSON200 {
+		tools[i] = toolRef{ID: tool.Id, Name: tool.Name}
+		serverTools[tool.Id] = true
+	}
+
+	// Agent assignments of those tools, which is what policies attach to
+	agentToolsByTool := map[string][]string{}
+	err = forEachAgentToolsPage(ctx, d.client, client.GetAllAgentToolsParams{}, d.pageSize, func(page *client.GetAllAgentToolsResponse) bool {
+		for _, agentTool := range page.JSON200.Data {
+			if serverTools[agentTool.Tool.Id] {
+				agentToolsByTool[agentTool.Tool.Id] = append(agentToolsByTool[agentTool.Tool.Id], agentTool.Id.String())
+			}
+		}
+		return true
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read agent tools, got error: %s", err))
+		return
+	}
+
+	// Policies per agent assignment
+	policiesResp, err := d.client.GetToolInvocationPoliciesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read tool invocation policies, got error: %s", err))
+		return
+	}
+
+	if policiesResp.JSON200 == nil {
+		resp.Diagnostics.AddError("Unexpected API Response", fmt.Sprintf("Expected 200 OK, got status %d", policiesResp.StatusCode()))
+		return
+	}
+
+	policyCounts := map[string]int{}
+	for _, policy := range *policiesResp.JSON200 {
+		policyCounts[policy.AgentToolId.String()]++
+	}
+
+	data.Tools, data.CoveredTools, data.UncoveredTools = toolPolicyCoverage(tools, agentToolsByTool, policyCounts)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// toolPolicyCoverage cross-references tools with the policies attached to
+// their agent assignments. agentToolsByTool maps a tool ID to its agent tool
+// IDs and policyCounts maps an agent tool ID to its number of policies.
+func toolPolicyCoverage(tools []toolRef, agentToolsByTool map[string][]string, policyCounts map[string]int) ([]ToolPolicyCoverageModel, []types.String, []types.String) {
+	sorted := make([]toolRef, len(tools))
+	copy(sorted, tools)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Name < sorted[j].Name
+	})
+
+	coverage := make([]ToolPolicyCoverageModel, len(sorted))
+	covered := []types.String{}
+	uncovered := []types.String{}
+
+	for i, tool := range sorted {
+		count := 0
+		for _, agentToolID := range agentToolsByTool[tool.ID] {
+			count += policyCounts[agentToolID]
+		}
+
+		coverage[i] = ToolPolicyCoverageModel{
+			ID:          types.StringValue(tool.ID),
+			Name:        types.StringValue(tool.Name),
+			PolicyCount: types.Int64Value(int64(count)),
+			Covered:     types.BoolValue(count > 0),
+		}
+
+		if count > 0 {
+			covered = append(covered, types.StringValue(tool.Name))
+		} else {
+			uncovered = append(uncovered, types.StringValue(tool.Name))
+		}
+	}
+
+	return coverage, covered, uncovered
+}
@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"testing"
+)
+
+func TestToolPolicyCoverage(t *testing.T) {
+	tools := []toolRef{
+		{ID: "tool-write", Name: "write_file"},
+		{ID: "tool-read", Name: "read_file"},
+		{ID: "tool-list", Name: "list_directory"},
+	}
+	agentToolsByTool := map[string][]string{
+		// read_file is assigned to two agents, one of which has policies
+		"tool-read": {"agent-tool-1", "agent-tool-2"},
+		// write_file is assigned but has no policies
+		"tool-write": {"agent-tool-3"},
+		// list_directory is not assigned to any agent
+	}
+	policyCounts := map[string]int{
+		"agent-tool-2": 2,
+		// A policy for a tool of another server is ignored
+		"agent-tool-other": 1,
+	}
+
+	coverage, covered, uncovered := toolPolicyCoverage(tools, agentToolsByTool, policyCounts)
+
+	expected := []struct {
+		name        string
+		policyCount int64
+		covered     bool
+	}{
+		{"list_directory", 0, false},
+		{"read_file", 2, true},
+		{"write_file", 0, false},
+	}
+
+	if len(coverage) != len(expected) {
+		t.Fatalf("Expected %d tools, got %d", len(expected), len(coverage))
+	}
+	for i, want := range expected {
+		got := coverage[i]
+		if got.Name.ValueString() != want.name || got.PolicyCount.ValueInt64() != want.policyCount || got.Covered.ValueBool() != want.covered {
+			t.Errorf("Tool %d: expected %+v, got name=%s policy_count=%d covered=%t",
+				i, want, got.Name.ValueString(), got.PolicyCount.ValueInt64(), got.Covered.ValueBool())
+		}
+	}
+
+	if len(covered) != 1 || covered[0].ValueString() != "read_file" {
+		t.Errorf("Expected covered tools [read_file], got %v", covered)
+	}
+	if len(uncovered) != 2 || uncovered[0].ValueString() != "list_directory" || uncovered[1].ValueString() != "write_file" {
+		t.Errorf("Expected uncovered tools [list_directory write_file], got %v", uncovered)
+	}
+}
+
+func TestToolPolicyCoverage_NoTools(t *testing.T) {
+	coverage, covered, uncovered := toolPolicyCoverage(nil, nil, nil)
+	if len(coverage) != 0 || len(covered) != 0 || len(uncovered) != 0 {
+		t.Errorf("Expected empty coverage, got %v %v %v", coverage, covered, uncovered)
+	}
+}
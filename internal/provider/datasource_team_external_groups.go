@@ -92,16 +92,16 @@ func (d *TeamExternalGroupsDataSource) Configure(ctx context.Context, req dataso
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraDataSourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraDataSourceData, got: %T", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 // ---------------------
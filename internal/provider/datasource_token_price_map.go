@@ -0,0 +1,160 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ datasource.DataSource = &TokenPriceMapDataSource{}
+
+func NewTokenPriceMapDataSource() datasource.DataSource {
+	return &TokenPriceMapDataSource{}
+}
+
+type TokenPriceMapDataSource struct {
+	client *client.ClientWithResponses
+}
+
+type TokenPriceMapDataSourceModel struct {
+	Prices types.Map `tfsdk:"prices"`
+}
+
+// tokenPriceEntry is a token price as returned by the list endpoint.
+type tokenPriceEntry struct {
+	ID       string
+	Provider string
+	Model    string
+	Input    string
+	Output   string
+}
+
+var tokenPriceMapEntryAttrTypes = map[string]attr.Type{
+	"id":     types.StringType,
+	"input":  types.StringType,
+	"output": types.StringType,
+}
+
+// tokenPriceModelMapType is the type of the token prices of one provider,
+// keyed by model.
+var tokenPriceModelMapType = types.MapType{
+	ElemType: types.ObjectType{AttrTypes: tokenPriceMapEntryAttrTypes},
+}
+
+func (d *TokenPriceMapDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token_price_map"
+}
+
+func (d *TokenPriceMapDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches all token prices from Archestra as a map keyed by provider, then model. " +
+			"Each entry has the `id` of the token price and its `input` and `output` price per million tokens, " +
+			"for example `data.archestra_token_price_map.all.prices[\"openai\"][\"gpt-4o\"].input`.",
+
+		Attributes: map[string]schema.Attribute{
+			"prices": schema.MapAttribute{
+				MarkdownDescription: "Token prices keyed by provider, then model",
+				Computed:            true,
+				ElementType:         tokenPriceModelMapType,
+			},
+		},
+	}
+}
+
+func (d *TokenPriceMapDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ArchestraDataSourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *provider.ArchestraDataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = data.Client
+}
+
+func (d *TokenPriceMapDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TokenPriceMapDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := d.client.GetTokenPricesWithResponse(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read token prices, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	entries := make([]tokenPriceEntry, len(*apiResp.JSON200))
+	for i, tp := range *apiResp.JSON200 {
+		entries[i] = tokenPriceEntry{
+			ID:       tp.Id.String(),
+			Provider: tp.Provider,
+			Model:    tp.Model,
+			Input:    tp.PricePerMillionInput,
+			Output:   tp.PricePerMillionOutput,
+		}
+	}
+
+	prices, diags := tokenPriceMap(entries)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	data.Prices = prices
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// tokenPriceMap nests the token prices by provider, then model.
+func tokenPriceMap(entries []tokenPriceEntry) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	byProvider := map[string]map[string]attr.Value{}
+	for _, entry := range entries {
+		if byProvider[entry.Provider] == nil {
+			byProvider[entry.Provider] = map[string]attr.Value{}
+		}
+
+		price, d := types.ObjectValue(tokenPriceMapEntryAttrTypes, map[string]attr.Value{
+			"id":     types.StringValue(entry.ID),
+			"input":  types.StringValue(entry.Input),
+			"output": types.StringValue(entry.Output),
+		})
+		diags.Append(d...)
+		byProvider[entry.Provider][entry.Model] = price
+	}
+
+	providers := make(map[string]attr.Value, len(byProvider))
+	for provider, models := range byProvider {
+		modelMap, d := types.MapValue(tokenPriceModelMapType.ElemType, models)
+		diags.Append(d...)
+		providers[provider] = modelMap
+	}
+
+	prices, d := types.MapValue(tokenPriceModelMapType, providers)
+	diags.Append(d...)
+
+	return prices, diags
+}
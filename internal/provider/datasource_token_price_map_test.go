@@ -0,0 +1,83 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTokenPriceMapDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTokenPriceMapDataSourceConfig(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.archestra_token_price_map.all", "prices.openai.gpt-4o-tf-map-test.input", "2.50"),
+					resource.TestCheckResourceAttr("data.archestra_token_price_map.all", "prices.openai.gpt-4o-tf-map-test.output", "10.00"),
+					resource.TestCheckResourceAttrPair(
+						"data.archestra_token_price_map.all", "prices.openai.gpt-4o-tf-map-test.id",
+						"archestra_token_price.test", "id",
+					),
+				),
+			},
+		},
+	})
+}
+
+func testAccTokenPriceMapDataSourceConfig() string {
+	return `
+resource "archestra_token_price" "test" {
+  llm_provider             = "openai"
+  model                    = "gpt-4o-tf-map-test"
+  price_per_million_input  = "2.50"
+  price_per_million_output = "10.00"
+}
+
+data "archestra_token_price_map" "all" {
+  depends_on = [archestra_token_price.test]
+}
+`
+}
+
+func TestTokenPriceMap(t *testing.T) {
+	prices, diags := tokenPriceMap([]tokenPriceEntry{
+		{ID: "1", Provider: "openai", Model: "gpt-4o", Input: "2.50", Output: "10.00"},
+		{ID: "2", Provider: "openai", Model: "gpt-4o-mini", Input: "0.15", Output: "0.60"},
+		{ID: "3", Provider: "anthropic", Model: "claude-sonnet", Input: "3.00", Output: "15.00"},
+	})
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags)
+	}
+
+	providers := prices.Elements()
+	if len(providers) != 2 {
+		t.Fatalf("Expected 2 providers, got %d", len(providers))
+	}
+
+	openai, ok := providers["openai"].(types.Map)
+	if !ok {
+		t.Fatalf("Expected openai to be a map of models, got %T", providers["openai"])
+	}
+	if len(openai.Elements()) != 2 {
+		t.Errorf("Expected 2 openai models, got %d", len(openai.Elements()))
+	}
+
+	gpt4o, ok := openai.Elements()["gpt-4o"].(types.Object)
+	if !ok {
+		t.Fatalf("Expected gpt-4o to be an object, got %T", openai.Elements()["gpt-4o"])
+	}
+	attributes := gpt4o.Attributes()
+	if attributes["id"].(types.String).ValueString() != "1" ||
+		attributes["input"].(types.String).ValueString() != "2.50" ||
+		attributes["output"].(types.String).ValueString() != "10.00" {
+		t.Errorf("Unexpected gpt-4o price: %v", attributes)
+	}
+
+	anthropic := providers["anthropic"].(types.Map)
+	if _, ok := anthropic.Elements()["claude-sonnet"]; !ok {
+		t.Error("Expected claude-sonnet under anthropic")
+	}
+}
@@ -2,7 +2,9 @@ package provider
 
 import (
 	"context"
+	"encoding/csv"
 	"fmt"
+	"strings"
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -33,6 +35,7 @@ type TokenPriceModel struct {
 // TokenPricesDataSourceModel describes the data source data model.
 type TokenPricesDataSourceModel struct {
 	TokenPrices []TokenPriceModel `tfsdk:"token_prices"`
+	CSV         types.String      `tfsdk:"csv"`
 }
 
 func (d *TokenPricesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,6 +71,10 @@ func (d *TokenPricesDataSource) Schema(ctx context.Context, req datasource.Schem
 					},
 				},
 			},
+			"csv": schema.StringAttribute{
+				MarkdownDescription: "The token prices as CSV text with a `provider,model,input,output` header row, e.g. for writing with `local_file`",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -77,16 +84,16 @@ func (d *TokenPricesDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraDataSourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraDataSourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = data.Client
 }
 
 func (d *TokenPricesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
@@ -113,6 +120,7 @@ func (d *TokenPricesDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 	tokenPrices := *apiResp.JSON200
 	data.TokenPrices = make([]TokenPriceModel, len(tokenPrices))
+	entries := make([]tokenPriceEntry, len(tokenPrices))
 	for i, tp := range tokenPrices {
 		data.TokenPrices[i] = TokenPriceModel{
 			ID:                    types.StringValue(tp.Id.String()),
@@ -120,7 +128,44 @@ func (d *TokenPricesDataSource) Read(ctx context.Context, req datasource.ReadReq
 			PricePerMillionInput:  types.StringValue(tp.PricePerMillionInput),
 			PricePerMillionOutput: types.StringValue(tp.PricePerMillionOutput),
 		}
+		entries[i] = tokenPriceEntry{
+			ID:       tp.Id.String(),
+			Provider: tp.Provider,
+			Model:    tp.Model,
+			Input:    tp.PricePerMillionInput,
+			Output:   tp.PricePerMillionOutput,
+		}
+	}
+
+	csvText, err := tokenPricesCSV(entries)
+	if err != nil {
+		resp.Diagnostics.AddError("CSV Error", fmt.Sprintf("Unable to render token prices as CSV: %s", err))
+		return
 	}
+	data.CSV = types.StringValue(csvText)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// tokenPricesCSV renders token prices as CSV with a header row. Fields are
+// quoted as needed by encoding/csv.
+func tokenPricesCSV(entries []tokenPriceEntry) (string, error) {
+	var buf strings.Builder
+	w := csv.NewWriter(&buf)
+
+	if err := w.Write([]string{"provider", "model", "input", "output"}); err != nil {
+		return "", err
+	}
+	for _, entry := range entries {
+		if err := w.Write([]string{entry.Provider, entry.Model, entry.Input, entry.Output}); err != nil {
+			return "", err
+		}
+	}
+
+	w.Flush()
+	if err := w.Error(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
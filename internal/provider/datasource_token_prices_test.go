@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -16,6 +17,7 @@ func TestAccTokenPricesDataSource(t *testing.T) {
 				Config: testAccTokenPricesDataSourceConfig(),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.archestra_token_prices.all", "token_prices.#"),
+					resource.TestMatchResourceAttr("data.archestra_token_prices.all", "csv", regexp.MustCompile(`^provider,model,input,output\n`)),
 				),
 			},
 		},
@@ -27,3 +29,30 @@ func testAccTokenPricesDataSourceConfig() string {
 data "archestra_token_prices" "all" {}
 `
 }
+
+func TestTokenPricesCSV(t *testing.T) {
+	csvText, err := tokenPricesCSV([]tokenPriceEntry{
+		{Provider: "openai", Model: "gpt-4o", Input: "2.50", Output: "10.00"},
+		{Provider: "custom", Model: `fine-tuned, "v2"`, Input: "1.00", Output: "2.00"},
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := "provider,model,input,output\n" +
+		"openai,gpt-4o,2.50,10.00\n" +
+		`custom,"fine-tuned, ""v2""",1.00,2.00` + "\n"
+	if csvText != expected {
+		t.Errorf("Expected CSV:\n%s\ngot:\n%s", expected, csvText)
+	}
+}
+
+func TestTokenPricesCSV_Empty(t *testing.T) {
+	csvText, err := tokenPricesCSV(nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if csvText != "provider,model,input,output\n" {
+		t.Errorf("Expected only the header row, got %q", csvText)
+	}
+}
@@ -0,0 +1,28 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// statusCoder is implemented by every generated API response.
+type statusCoder interface {
+	StatusCode() int
+}
+
+// handleDelete checks the response of a delete API call. A resource that is
+// already gone (404 Not Found) counts as deleted, and so does any success
+// status regardless of whether the API returns a body.
+func handleDelete(resp *resource.DeleteResponse, apiResp statusCoder) {
+	switch apiResp.StatusCode() {
+	case http.StatusOK, http.StatusAccepted, http.StatusNoContent, http.StatusNotFound:
+		return
+	}
+
+	resp.Diagnostics.AddError(
+		"Unexpected API Response",
+		fmt.Sprintf("Expected 200 OK, 202 Accepted, 204 No Content or 404 Not Found, got status %d", apiResp.StatusCode()),
+	)
+}
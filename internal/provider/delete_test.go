@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+type testStatusCoder int
+
+func (s testStatusCoder) StatusCode() int {
+	return int(s)
+}
+
+func TestHandleDelete(t *testing.T) {
+	tests := []struct {
+		status    int
+		expectErr bool
+	}{
+		{200, false},
+		{202, false},
+		{204, false},
+		{404, false},
+		{400, true},
+		{401, true},
+		{403, true},
+		{409, true},
+		{500, true},
+	}
+
+	for _, tt := range tests {
+		resp := &resource.DeleteResponse{}
+		handleDelete(resp, testStatusCoder(tt.status))
+
+		if resp.Diagnostics.HasError() != tt.expectErr {
+			t.Errorf("Status %d: expected error %t, got diagnostics %v", tt.status, tt.expectErr, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// Values of the on_missing provider attribute
+const (
+	onMissingRemove = "remove"
+	onMissingError  = "error"
+)
+
+// handleMissingResource is called from Read when the API no longer knows a
+// managed resource. Depending on the on_missing provider attribute it removes
+// the resource from state, so that it is recreated on the next apply, or fails
+// the refresh so that accidental deletions are noticed.
+func handleMissingResource(ctx context.Context, resp *resource.ReadResponse, onMissing string, description string, id string) {
+	if onMissing == onMissingError {
+		resp.Diagnostics.AddError(
+			"Resource Not Found",
+			fmt.Sprintf("%s %s no longer exists in Archestra. It may have been deleted outside of Terraform. "+
+				"Set the provider attribute on_missing to \"remove\" to remove it from state and recreate it, "+
+				"or remove it from state with terraform state rm.", description, id),
+		)
+		return
+	}
+
+	resp.State.RemoveResource(ctx)
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func testMissingResourceReadResponse() *resource.ReadResponse {
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.String}}
+	return &resource.ReadResponse{
+		State: tfsdk.State{
+			Schema: schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{Computed: true},
+				},
+			},
+			Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "gone"),
+			}),
+		},
+	}
+}
+
+func TestHandleMissingResource_Remove(t *testing.T) {
+	resp := testMissingResourceReadResponse()
+
+	handleMissingResource(t.Context(), resp, onMissingRemove, "Team", "gone")
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Error("Expected the resource to be removed from state")
+	}
+}
+
+func TestHandleMissingResource_Error(t *testing.T) {
+	resp := testMissingResourceReadResponse()
+
+	handleMissingResource(t.Context(), resp, onMissingError, "Team", "gone")
+
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error when on_missing is error")
+	}
+	if resp.State.Raw.IsNull() {
+		t.Error("Expected the resource to be kept in state")
+	}
+}
@@ -0,0 +1,52 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+)
+
+// forEachAgentToolsPage reads every page of agent tools matching params and
+// calls fn with each page until fn returns false or there are no more pages.
+// pageSize is sent as the limit query parameter; 0 uses the API default.
+func forEachAgentToolsPage(
+	ctx context.Context,
+	c *client.ClientWithResponses,
+	params client.GetAllAgentToolsParams,
+	pageSize int,
+	fn func(page *client.GetAllAgentToolsResponse) bool,
+) error {
+	if pageSize > 0 {
+		params.Limit = &pageSize
+	}
+
+	offset := 0
+	for {
+		params.Offset = &offset
+
+		page, err := c.GetAllAgentToolsWithResponse(ctx, &params)
+		if err != nil {
+			return err
+		}
+
+		if page.JSON200 == nil {
+			return fmt.Errorf("expected 200 OK, got status %d", page.StatusCode())
+		}
+
+		if !fn(page) || !page.JSON200.Pagination.HasNext {
+			return nil
+		}
+
+		// Advance by the page size the API actually used, which is its
+		// default when no limit was sent.
+		step := page.JSON200.Pagination.Limit
+		if step <= 0 {
+			step = len(page.JSON200.Data)
+		}
+		if step == 0 {
+			return nil
+		}
+		offset += step
+	}
+}
@@ -0,0 +1,108 @@
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+)
+
+// newAgentToolsPagesServer returns a client for a server that serves pages of
+// agent tools out of total, using defaultLimit when no limit is sent, and
+// records the query of every request.
+func newAgentToolsPagesServer(t *testing.T, total, defaultLimit int, queries *[]url.Values) *client.ClientWithResponses {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		*queries = append(*queries, query)
+
+		limit := defaultLimit
+		if query.Has("limit") {
+			_, _ = fmt.Sscan(query.Get("limit"), &limit)
+		}
+		offset := 0
+		_, _ = fmt.Sscan(query.Get("offset"), &offset)
+
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"data":[],"pagination":{"currentPage":%d,"hasNext":%t,"hasPrev":%t,"limit":%d,"total":%d,"totalPages":%d}}`,
+			offset/limit+1, offset+limit < total, offset > 0, limit, total, (total+limit-1)/limit)
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	return apiClient
+}
+
+func TestForEachAgentToolsPage_SendsPageSize(t *testing.T) {
+	var queries []url.Values
+	apiClient := newAgentToolsPagesServer(t, 60, 20, &queries)
+
+	pages := 0
+	err := forEachAgentToolsPage(t.Context(), apiClient, client.GetAllAgentToolsParams{}, 25, func(page *client.GetAllAgentToolsResponse) bool {
+		pages++
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if pages != 3 {
+		t.Fatalf("Expected 3 pages, got %d", pages)
+	}
+	for i, query := range queries {
+		if query.Get("limit") != "25" {
+			t.Errorf("Expected limit=25 on request %d, got %q", i, query.Get("limit"))
+		}
+		if expected := fmt.Sprint(i * 25); query.Get("offset") != expected {
+			t.Errorf("Expected offset=%s on request %d, got %q", expected, i, query.Get("offset"))
+		}
+	}
+}
+
+func TestForEachAgentToolsPage_APIDefault(t *testing.T) {
+	var queries []url.Values
+	apiClient := newAgentToolsPagesServer(t, 50, 20, &queries)
+
+	err := forEachAgentToolsPage(t.Context(), apiClient, client.GetAllAgentToolsParams{}, 0, func(page *client.GetAllAgentToolsResponse) bool {
+		return true
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(queries) != 3 {
+		t.Fatalf("Expected 3 requests, got %d", len(queries))
+	}
+	for i, query := range queries {
+		if query.Has("limit") {
+			t.Errorf("Expected no limit on request %d, got %q", i, query.Get("limit"))
+		}
+		if expected := fmt.Sprint(i * 20); query.Get("offset") != expected {
+			t.Errorf("Expected offset=%s on request %d, got %q", expected, i, query.Get("offset"))
+		}
+	}
+}
+
+func TestForEachAgentToolsPage_StopsEarly(t *testing.T) {
+	var queries []url.Values
+	apiClient := newAgentToolsPagesServer(t, 100, 20, &queries)
+
+	err := forEachAgentToolsPage(t.Context(), apiClient, client.GetAllAgentToolsParams{}, 10, func(page *client.GetAllAgentToolsResponse) bool {
+		return false
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(queries) != 1 {
+		t.Errorf("Expected a single request, got %d", len(queries))
+	}
+}
@@ -1,16 +1,28 @@
 package provider
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -27,8 +39,45 @@ type ArchestraProvider struct {
 
 // ArchestraProviderModel describes the provider data model.
 type ArchestraProviderModel struct {
-	BaseURL types.String `tfsdk:"base_url"`
-	APIKey  types.String `tfsdk:"api_key"`
+	BaseURL        types.String `tfsdk:"base_url"`
+	APIKey         types.String `tfsdk:"api_key"`
+	ExtraHeaders   types.Map    `tfsdk:"extra_headers"`
+	RequestContext types.Map    `tfsdk:"request_context"`
+	ConfigFile     types.String `tfsdk:"config_file"`
+	OnMissing      types.String `tfsdk:"on_missing"`
+	PageSize       types.Int64  `tfsdk:"page_size"`
+
+	DefaultCreateTimeout types.String `tfsdk:"default_create_timeout"`
+	DefaultReadTimeout   types.String `tfsdk:"default_read_timeout"`
+	DefaultUpdateTimeout types.String `tfsdk:"default_update_timeout"`
+	DefaultDeleteTimeout types.String `tfsdk:"default_delete_timeout"`
+}
+
+// ArchestraDataSourceData is passed to data sources by the provider.
+type ArchestraDataSourceData struct {
+	Client *client.ClientWithResponses
+	// PageSize is the number of items requested per page by list data
+	// sources, or 0 to use the API default.
+	PageSize int
+	// RateLimits holds the latest rate limit headers seen by Client.
+	RateLimits *rateLimitTracker
+}
+
+// ArchestraResourceData is passed to resources by the provider.
+type ArchestraResourceData struct {
+	Client *client.ClientWithResponses
+	// OnMissing controls what Read does when a managed resource is not
+	// found, see handleMissingResource.
+	OnMissing string
+	// Timeouts bound each resource operation, see withTimeout.
+	Timeouts operationTimeouts
+}
+
+// providerConfigFile describes the JSON config file referenced by config_file
+// or the ARCHESTRA_CONFIG environment variable.
+type providerConfigFile struct {
+	BaseURL string `json:"base_url"`
+	APIKey  string `json:"api_key"`
 }
 
 func (p *ArchestraProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -50,6 +99,76 @@ func (p *ArchestraProvider) Schema(ctx context.Context, req provider.SchemaReque
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"extra_headers": schema.MapAttribute{
+				MarkdownDescription: "Additional HTTP headers sent with every API request (e.g. for tracing or API gateways). " +
+					"May also be provided as a JSON object via the ARCHESTRA_EXTRA_HEADERS environment variable; headers set here take precedence over the environment.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"request_context": schema.MapAttribute{
+				MarkdownDescription: "Static context values (e.g. a tenant id or environment tag) sent with every API request. " +
+					"Each key is sent as an `X-Archestra-Context-<Key>` header, so `tenant_id` becomes `X-Archestra-Context-Tenant-Id`. " +
+					"Keys may contain letters, digits, `_` and `-`; keys that differ only in case or separator are rejected. Headers in `extra_headers` take precedence over request context headers.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(
+						regexp.MustCompile(`^[A-Za-z0-9]+([_-][A-Za-z0-9]+)*$`),
+						"must contain only letters, digits, and single _ or - separators",
+					)),
+				},
+			},
+			"config_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a JSON config file (e.g. `~/.archestra/config.json`) containing `base_url` and `api_key`. " +
+					"May also be provided via the ARCHESTRA_CONFIG environment variable. " +
+					"Values from the file are only used when neither the provider configuration nor the corresponding environment variable sets them.",
+				Optional: true,
+			},
+			"on_missing": schema.StringAttribute{
+				MarkdownDescription: "What to do when a managed resource no longer exists in Archestra during refresh: " +
+					"`remove` removes it from state so it is recreated on the next apply, " +
+					"`error` fails the refresh so accidental deletions are noticed. Defaults to `remove`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(onMissingRemove, onMissingError),
+				},
+			},
+			"page_size": schema.Int64Attribute{
+				MarkdownDescription: "Number of items requested per page by data sources that read paginated lists, between 1 and 100. " +
+					"Larger pages mean fewer requests in large organizations. Defaults to the API's default page size.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"default_create_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum duration of a resource create, as a Go duration string (e.g. `10m`). No timeout by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					timeoutValidator{},
+				},
+			},
+			"default_read_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum duration of a resource read, as a Go duration string (e.g. `2m`). No timeout by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					timeoutValidator{},
+				},
+			},
+			"default_update_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum duration of a resource update, as a Go duration string (e.g. `10m`). No timeout by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					timeoutValidator{},
+				},
+			},
+			"default_delete_timeout": schema.StringAttribute{
+				MarkdownDescription: "Maximum duration of a resource delete, as a Go duration string (e.g. `10m`). No timeout by default.",
+				Optional:            true,
+				Validators: []validator.String{
+					timeoutValidator{},
+				},
+			},
 		},
 	}
 }
@@ -88,33 +207,143 @@ func (p *ArchestraProvider) Configure(ctx context.Context, req provider.Configur
 		)
 	}
 
+	if config.ExtraHeaders.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("extra_headers"),
+			"Unknown Archestra API Extra Headers",
+			"The provider cannot create the Archestra API client as there is an unknown configuration value for the extra headers. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ARCHESTRA_EXTRA_HEADERS environment variable.",
+		)
+	}
+
+	if config.RequestContext.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_context"),
+			"Unknown Archestra Request Context",
+			"The provider cannot create the Archestra API client as there is an unknown configuration value for the request context. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.ConfigFile.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("config_file"),
+			"Unknown Archestra Config File",
+			"The provider cannot create the Archestra API client as there is an unknown configuration value for the Archestra config file. "+
+				"Either target apply the source of the value first, set the value statically in the configuration, or use the ARCHESTRA_CONFIG environment variable.",
+		)
+	}
+
+	if config.OnMissing.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("on_missing"),
+			"Unknown Archestra On Missing Behavior",
+			"The provider cannot be configured as there is an unknown configuration value for on_missing. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	if config.PageSize.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("page_size"),
+			"Unknown Archestra Page Size",
+			"The provider cannot be configured as there is an unknown configuration value for page_size. "+
+				"Either target apply the source of the value first or set the value statically in the configuration.",
+		)
+	}
+
+	for _, timeout := range []struct {
+		attribute string
+		value     types.String
+	}{
+		{"default_create_timeout", config.DefaultCreateTimeout},
+		{"default_read_timeout", config.DefaultReadTimeout},
+		{"default_update_timeout", config.DefaultUpdateTimeout},
+		{"default_delete_timeout", config.DefaultDeleteTimeout},
+	} {
+		if timeout.value.IsUnknown() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(timeout.attribute),
+				"Unknown Archestra Default Timeout",
+				fmt.Sprintf("The provider cannot be configured as there is an unknown configuration value for %s. "+
+					"Either target apply the source of the value first or set the value statically in the configuration.", timeout.attribute),
+			)
+		}
+	}
+
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	// Default values to environment variables, but override
-	// with Terraform configuration value if set.
+	// Load the config file, if any, as the lowest precedence source.
 
-	if baseURL == "" {
-		if envBaseURL := os.Getenv("ARCHESTRA_BASE_URL"); envBaseURL != "" {
-			baseURL = envBaseURL
-		} else {
-			baseURL = "http://localhost:9000"
-		}
+	configFilePath := config.ConfigFile.ValueString()
+	if configFilePath == "" {
+		configFilePath = os.Getenv("ARCHESTRA_CONFIG")
 	}
 
-	if apiKey == "" {
-		if envAPIKey := os.Getenv("ARCHESTRA_API_KEY"); envAPIKey != "" {
-			apiKey = envAPIKey
-		} else {
+	var fileConfig providerConfigFile
+	if configFilePath != "" {
+		loaded, err := loadProviderConfigFile(configFilePath)
+		if err != nil {
 			resp.Diagnostics.AddAttributeError(
-				path.Root("api_key"),
-				"Missing Archestra API Key",
-				"The provider cannot create the Archestra API client as there is a missing or empty value for the Archestra API key. "+
-					"Set the api_key value in the configuration or use the ARCHESTRA_API_KEY environment variable. "+
-					"If either is already set, ensure the value is not empty.",
+				path.Root("config_file"),
+				"Invalid Archestra Config File",
+				"The provider cannot create the Archestra API client as the config file could not be loaded. "+
+					`The file must be a JSON object with optional "base_url" and "api_key" string values.`+"\n\n"+
+					"Error: "+err.Error(),
 			)
+			return
 		}
+		fileConfig = *loaded
+	}
+
+	// Default values to environment variables, then to the config file, but
+	// override with Terraform configuration value if set.
+
+	baseURL = firstNonEmpty(baseURL, os.Getenv("ARCHESTRA_BASE_URL"), fileConfig.BaseURL, "http://localhost:9000")
+	apiKey = firstNonEmpty(apiKey, os.Getenv("ARCHESTRA_API_KEY"), fileConfig.APIKey)
+
+	if apiKey == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("api_key"),
+			"Missing Archestra API Key",
+			"The provider cannot create the Archestra API client as there is a missing or empty value for the Archestra API key. "+
+				"Set the api_key value in the configuration, use the ARCHESTRA_API_KEY environment variable or set api_key in the config file. "+
+				"If any is already set, ensure the value is not empty.",
+		)
+	}
+
+	var requestContext map[string]string
+	if !config.RequestContext.IsNull() {
+		resp.Diagnostics.Append(config.RequestContext.ElementsAs(ctx, &requestContext, false)...)
+	}
+
+	contextHeaders, err := requestContextHeaders(requestContext)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_context"),
+			"Conflicting Archestra Request Context Keys",
+			"The provider cannot create the Archestra API client as two request_context keys map to the same header. "+
+				"Keys are compared ignoring case and the _ and - separators; keep only one of them.\n\n"+
+				"Error: "+err.Error(),
+		)
+	}
+
+	var configuredHeaders map[string]string
+	if !config.ExtraHeaders.IsNull() {
+		resp.Diagnostics.Append(config.ExtraHeaders.ElementsAs(ctx, &configuredHeaders, false)...)
+	}
+
+	extraHeaders, err := mergeExtraHeaders(os.Getenv("ARCHESTRA_EXTRA_HEADERS"), configuredHeaders)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("extra_headers"),
+			"Invalid ARCHESTRA_EXTRA_HEADERS Environment Variable",
+			"The provider cannot create the Archestra API client as the ARCHESTRA_EXTRA_HEADERS environment variable is not a valid JSON object of string values, "+
+				`for example {"X-Trace-Id": "abc"}.`+"\n\n"+
+				"Error: "+err.Error(),
+		)
 	}
 
 	if resp.Diagnostics.HasError() {
@@ -122,9 +351,17 @@ func (p *ArchestraProvider) Configure(ctx context.Context, req provider.Configur
 	}
 
 	// Create a new Archestra client using the configuration values
+	rateLimits := &rateLimitTracker{}
 	apiClient, err := client.NewClientWithResponses(
 		baseURL,
+		client.WithHTTPClient(&rateLimitRecorder{next: http.DefaultClient, tracker: rateLimits}),
 		client.WithRequestEditorFn(func(ctx context.Context, req *http.Request) error {
+			for name, value := range contextHeaders {
+				req.Header.Set(name, value)
+			}
+			for name, value := range extraHeaders {
+				req.Header.Set(name, value)
+			}
 			req.Header.Set("Authorization", apiKey)
 			return nil
 		}),
@@ -140,10 +377,54 @@ func (p *ArchestraProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	onMissing := config.OnMissing.ValueString()
+	if onMissing == "" {
+		onMissing = onMissingRemove
+	}
+
+	var timeouts operationTimeouts
+	for _, timeout := range []struct {
+		attribute string
+		value     types.String
+		target    *time.Duration
+	}{
+		{"default_create_timeout", config.DefaultCreateTimeout, &timeouts.Create},
+		{"default_read_timeout", config.DefaultReadTimeout, &timeouts.Read},
+		{"default_update_timeout", config.DefaultUpdateTimeout, &timeouts.Update},
+		{"default_delete_timeout", config.DefaultDeleteTimeout, &timeouts.Delete},
+	} {
+		if timeout.value.IsNull() {
+			continue
+		}
+
+		duration, err := parseTimeout(timeout.value.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(timeout.attribute),
+				"Invalid Archestra Default Timeout",
+				fmt.Sprintf("The %s value %q must be a positive Go duration string, for example \"10m\" or \"90s\".", timeout.attribute, timeout.value.ValueString()),
+			)
+			continue
+		}
+		*timeout.target = duration
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Make the Archestra client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = apiClient
-	resp.ResourceData = apiClient
+	resp.DataSourceData = &ArchestraDataSourceData{
+		Client:     apiClient,
+		PageSize:   int(config.PageSize.ValueInt64()),
+		RateLimits: rateLimits,
+	}
+	resp.ResourceData = &ArchestraResourceData{
+		Client:    apiClient,
+		OnMissing: onMissing,
+		Timeouts:  timeouts,
+	}
 }
 
 func (p *ArchestraProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -161,6 +442,7 @@ func (p *ArchestraProvider) Resources(ctx context.Context) []func() resource.Res
 		// NewUserResource, // TODO: Enable when user API endpoints are implemented
 		NewTeamExternalGroupResource,
 		NewChatLLMProviderApiKeyResource,
+		NewSSORoleMappingResource,
 	}
 }
 
@@ -172,7 +454,101 @@ func (p *ArchestraProvider) DataSources(ctx context.Context) []func() datasource
 		NewMCPServerToolDataSource,
 		NewTokenPricesDataSource,
 		NewTeamExternalGroupsDataSource,
+		NewMCPServerDiagnosticsDataSource,
+		NewManagedFieldsDataSource,
+		NewMCPServerToolPolicyCoverageDataSource,
+		NewOrganizationDataSource,
+		NewTokenPriceMapDataSource,
+		NewAPIStatusDataSource,
+	}
+}
+
+// mergeExtraHeaders parses envJSON (the ARCHESTRA_EXTRA_HEADERS environment
+// variable) as a JSON object of header values and overlays the headers
+// configured in HCL on top of it.
+func mergeExtraHeaders(envJSON string, configured map[string]string) (map[string]string, error) {
+	headers := map[string]string{}
+
+	if envJSON != "" {
+		if err := json.Unmarshal([]byte(envJSON), &headers); err != nil {
+			return nil, err
+		}
+		if headers == nil {
+			return nil, fmt.Errorf("expected a JSON object, got null")
+		}
+	}
+
+	for name, value := range configured {
+		headers[name] = value
+	}
+
+	return headers, nil
+}
+
+// requestContextHeaders converts request_context values to headers: each key
+// is split on "_" and "-" and sent as X-Archestra-Context-<Key>. Keys that map
+// to the same header, such as tenant_id and Tenant-Id, are an error.
+func requestContextHeaders(values map[string]string) (map[string]string, error) {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	headers := make(map[string]string, len(values))
+	headerKeys := make(map[string]string, len(values))
+	for _, key := range keys {
+		parts := strings.FieldsFunc(key, func(r rune) bool {
+			return r == '_' || r == '-'
+		})
+		for i, part := range parts {
+			parts[i] = strings.ToUpper(part[:1]) + strings.ToLower(part[1:])
+		}
+		name := "X-Archestra-Context-" + strings.Join(parts, "-")
+
+		if existing, ok := headerKeys[name]; ok {
+			return nil, fmt.Errorf("keys %q and %q both map to the %s header", existing, key, name)
+		}
+		headerKeys[name] = key
+		headers[name] = values[key]
+	}
+	return headers, nil
+}
+
+// loadProviderConfigFile reads and validates the provider config file at
+// configPath. A leading "~/" is expanded to the user's home directory.
+func loadProviderConfigFile(configPath string) (*providerConfigFile, error) {
+	if rest, ok := strings.CutPrefix(configPath, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil, fmt.Errorf("unable to expand %q: %w", configPath, err)
+		}
+		configPath = filepath.Join(home, rest)
+	}
+
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var fileConfig providerConfigFile
+	decoder := json.NewDecoder(bytes.NewReader(content))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(&fileConfig); err != nil {
+		return nil, fmt.Errorf("unable to parse %s: %w", configPath, err)
+	}
+
+	return &fileConfig, nil
+}
+
+// firstNonEmpty returns the first non-empty value, in order of precedence.
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
 	}
+	return ""
 }
 
 func New(version string) func() provider.Provider {
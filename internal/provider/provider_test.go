@@ -1,11 +1,24 @@
 package provider
 
 import (
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories is used to instantiate a provider during acceptance testing.
@@ -44,7 +57,7 @@ func TestProviderResources_RegistrationCount(t *testing.T) {
 	resources := provider.Resources(t.Context())
 
 	// We expect this many resources to be registered
-	expectedCount := 12
+	expectedCount := 13
 	if len(resources) != expectedCount {
 		t.Errorf("Expected %d resources to be registered, got %d", expectedCount, len(resources))
 	}
@@ -58,8 +71,425 @@ func TestProviderDataSources_RegistrationCount(t *testing.T) {
 	dataSources := provider.DataSources(t.Context())
 
 	// We expect this many data sources to be registered
-	expectedCount := 5
+	expectedCount := 11
 	if len(dataSources) != expectedCount {
 		t.Errorf("Expected %d data sources to be registered, got %d", expectedCount, len(dataSources))
 	}
 }
+
+func TestMergeExtraHeaders_EnvOnly(t *testing.T) {
+	headers, err := mergeExtraHeaders(`{"X-Trace-Id": "abc", "X-Gateway": "ci"}`, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(headers) != 2 || headers["X-Trace-Id"] != "abc" || headers["X-Gateway"] != "ci" {
+		t.Errorf("Expected headers seeded from the environment, got %v", headers)
+	}
+}
+
+func TestMergeExtraHeaders_ConfigTakesPrecedence(t *testing.T) {
+	headers, err := mergeExtraHeaders(`{"X-Trace-Id": "from-env", "X-Gateway": "ci"}`, map[string]string{
+		"X-Trace-Id": "from-hcl",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if headers["X-Trace-Id"] != "from-hcl" {
+		t.Errorf("Expected configured header to win, got %q", headers["X-Trace-Id"])
+	}
+	if headers["X-Gateway"] != "ci" {
+		t.Errorf("Expected environment header to be kept, got %q", headers["X-Gateway"])
+	}
+}
+
+func TestMergeExtraHeaders_InvalidJSON(t *testing.T) {
+	for _, envJSON := range []string{`not json`, `["X-Trace-Id"]`, `{"X-Retries": 3}`, `null`} {
+		if _, err := mergeExtraHeaders(envJSON, nil); err == nil {
+			t.Errorf("Expected an error for ARCHESTRA_EXTRA_HEADERS=%s", envJSON)
+		}
+	}
+}
+
+// configureTestProvider runs the provider Configure with the given string,
+// number or map of string attributes set (all others null) and returns the
+// configured client.
+func configureTestProvider(t *testing.T, attributes map[string]any) (*client.ClientWithResponses, *provider.ConfigureResponse) {
+	t.Helper()
+
+	p := New("test")()
+	schemaResp := &provider.SchemaResponse{}
+	p.Schema(t.Context(), provider.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	values := map[string]tftypes.Value{}
+	for name, attrType := range objectType.AttributeTypes {
+		if value, ok := attributes[name].(map[string]string); ok {
+			elements := map[string]tftypes.Value{}
+			for key, element := range value {
+				elements[key] = tftypes.NewValue(tftypes.String, element)
+			}
+			values[name] = tftypes.NewValue(attrType, elements)
+		} else if value, ok := attributes[name]; ok {
+			values[name] = tftypes.NewValue(attrType, value)
+		} else {
+			values[name] = tftypes.NewValue(attrType, nil)
+		}
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(t.Context(), provider.ConfigureRequest{
+		Config: tfsdk.Config{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(objectType, values),
+		},
+	}, resp)
+
+	data, _ := resp.DataSourceData.(*ArchestraDataSourceData)
+	if data == nil {
+		return nil, resp
+	}
+	return data.Client, resp
+}
+
+// authorizationSeenBy issues a request with apiClient and returns the
+// Authorization header received by the server.
+func authorizationSeenBy(t *testing.T, apiClient *client.ClientWithResponses, received *string) string {
+	t.Helper()
+
+	if apiClient == nil {
+		t.Fatal("Expected the provider to configure a client")
+	}
+	if _, err := apiClient.GetOrganizationWithResponse(t.Context()); err != nil {
+		t.Fatalf("Unexpected error calling the API: %s", err)
+	}
+	return *received
+}
+
+func TestProviderConfigure_ConfigFilePrecedence(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("Authorization")
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	configFile := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(configFile, []byte(`{"base_url": "`+server.URL+`", "api_key": "from-file"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Setenv("ARCHESTRA_BASE_URL", "")
+	t.Setenv("ARCHESTRA_API_KEY", "")
+	t.Setenv("ARCHESTRA_CONFIG", configFile)
+
+	// The config file is used when nothing else is set
+	apiClient, resp := configureTestProvider(t, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if got := authorizationSeenBy(t, apiClient, &received); got != "from-file" {
+		t.Errorf("Expected the API key from the config file, got %q", got)
+	}
+
+	// The environment takes precedence over the config file
+	t.Setenv("ARCHESTRA_API_KEY", "from-env")
+	apiClient, _ = configureTestProvider(t, nil)
+	if got := authorizationSeenBy(t, apiClient, &received); got != "from-env" {
+		t.Errorf("Expected the API key from the environment, got %q", got)
+	}
+
+	// HCL takes precedence over both
+	apiClient, _ = configureTestProvider(t, map[string]any{"api_key": "from-hcl"})
+	if got := authorizationSeenBy(t, apiClient, &received); got != "from-hcl" {
+		t.Errorf("Expected the API key from the configuration, got %q", got)
+	}
+
+	// config_file takes precedence over ARCHESTRA_CONFIG
+	t.Setenv("ARCHESTRA_CONFIG", filepath.Join(t.TempDir(), "missing.json"))
+	apiClient, resp = configureTestProvider(t, map[string]any{"config_file": configFile})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if got := authorizationSeenBy(t, apiClient, &received); got != "from-env" {
+		t.Errorf("Expected the API key from the environment, got %q", got)
+	}
+}
+
+func TestProviderConfigure_InvalidConfigFile(t *testing.T) {
+	t.Setenv("ARCHESTRA_BASE_URL", "")
+	t.Setenv("ARCHESTRA_API_KEY", "")
+	t.Setenv("ARCHESTRA_CONFIG", "")
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"not-json.json":      `api_key = "abc"`,
+		"unknown-field.json": `{"api_key": "abc", "apiKey": "abc"}`,
+		"wrong-type.json":    `{"api_key": 123}`,
+	}
+
+	for name, content := range files {
+		configFile := filepath.Join(dir, name)
+		if err := os.WriteFile(configFile, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+
+		_, resp := configureTestProvider(t, map[string]any{"config_file": configFile})
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("Expected an error for config file %s", name)
+		}
+	}
+
+	_, resp := configureTestProvider(t, map[string]any{"config_file": filepath.Join(dir, "missing.json")})
+	if !resp.Diagnostics.HasError() {
+		t.Error("Expected an error for a missing config file")
+	}
+}
+
+func TestProviderConfigure_OnMissing(t *testing.T) {
+	t.Setenv("ARCHESTRA_API_KEY", "test-key")
+	t.Setenv("ARCHESTRA_CONFIG", "")
+
+	tests := map[string]string{
+		"":       onMissingRemove,
+		"remove": onMissingRemove,
+		"error":  onMissingError,
+	}
+
+	for configured, expected := range tests {
+		attributes := map[string]any{}
+		if configured != "" {
+			attributes["on_missing"] = configured
+		}
+
+		_, resp := configureTestProvider(t, attributes)
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+		}
+
+		resourceData, ok := resp.ResourceData.(*ArchestraResourceData)
+		if !ok {
+			t.Fatalf("Expected *ArchestraResourceData, got %T", resp.ResourceData)
+		}
+		if resourceData.OnMissing != expected {
+			t.Errorf("on_missing %q: expected %q, got %q", configured, expected, resourceData.OnMissing)
+		}
+	}
+}
+
+func TestProviderConfigure_DefaultTimeouts(t *testing.T) {
+	t.Setenv("ARCHESTRA_API_KEY", "test-key")
+	t.Setenv("ARCHESTRA_CONFIG", "")
+
+	_, resp := configureTestProvider(t, map[string]any{
+		"default_create_timeout": "10m",
+		"default_read_timeout":   "90s",
+		"default_delete_timeout": "1h",
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	resourceData := resp.ResourceData.(*ArchestraResourceData)
+	expected := operationTimeouts{
+		Create: 10 * time.Minute,
+		Read:   90 * time.Second,
+		// Update is not set, so it has no timeout
+		Delete: time.Hour,
+	}
+	if resourceData.Timeouts != expected {
+		t.Errorf("Expected timeouts %+v, got %+v", expected, resourceData.Timeouts)
+	}
+
+	// The defaults are applied when a resource is configured
+	r := &TeamResource{}
+	r.Configure(t.Context(), resource.ConfigureRequest{ProviderData: resourceData}, &resource.ConfigureResponse{})
+	if r.timeouts != expected {
+		t.Errorf("Expected resource timeouts %+v, got %+v", expected, r.timeouts)
+	}
+}
+
+func TestProviderConfigure_InvalidDefaultTimeout(t *testing.T) {
+	t.Setenv("ARCHESTRA_API_KEY", "test-key")
+	t.Setenv("ARCHESTRA_CONFIG", "")
+
+	for _, value := range []string{"ten minutes", "10", "-5m", "0s"} {
+		_, resp := configureTestProvider(t, map[string]any{"default_update_timeout": value})
+		if !resp.Diagnostics.HasError() {
+			t.Errorf("Expected an error for default_update_timeout %q", value)
+		}
+	}
+}
+
+func TestProviderConfigure_UnknownDefaultTimeout(t *testing.T) {
+	t.Setenv("ARCHESTRA_API_KEY", "test-key")
+	t.Setenv("ARCHESTRA_CONFIG", "")
+
+	_, resp := configureTestProvider(t, map[string]any{"default_read_timeout": tftypes.UnknownValue})
+	if !resp.Diagnostics.HasError() || resp.Diagnostics[0].Summary() != "Unknown Archestra Default Timeout" {
+		t.Fatalf("Expected an unknown default timeout error, got %v", resp.Diagnostics)
+	}
+	if resp.ResourceData != nil {
+		t.Error("Expected the provider not to be configured")
+	}
+}
+
+func TestTimeoutValidator(t *testing.T) {
+	tests := []struct {
+		value       types.String
+		expectError bool
+	}{
+		{value: types.StringValue("10m")},
+		{value: types.StringValue("1h30m")},
+		{value: types.StringNull()},
+		{value: types.StringUnknown()},
+		{value: types.StringValue("ten minutes"), expectError: true},
+		{value: types.StringValue("10"), expectError: true},
+		{value: types.StringValue("-5m"), expectError: true},
+		{value: types.StringValue("0s"), expectError: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.value.String(), func(t *testing.T) {
+			resp := &validator.StringResponse{}
+			timeoutValidator{}.ValidateString(t.Context(), validator.StringRequest{
+				Path:        path.Root("default_create_timeout"),
+				ConfigValue: tt.value,
+			}, resp)
+
+			if resp.Diagnostics.HasError() != tt.expectError {
+				t.Errorf("Expected error %t, got %v", tt.expectError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	ctx, cancel := withTimeout(t.Context(), time.Minute)
+	defer cancel()
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		t.Fatal("Expected a deadline")
+	}
+	if remaining := time.Until(deadline); remaining <= 0 || remaining > time.Minute {
+		t.Errorf("Expected the deadline within a minute, got %s", remaining)
+	}
+
+	ctx, cancel = withTimeout(t.Context(), 0)
+	defer cancel()
+
+	if _, ok := ctx.Deadline(); ok {
+		t.Error("Expected no deadline for a zero timeout")
+	}
+}
+
+func TestRequestContextHeaders(t *testing.T) {
+	headers, err := requestContextHeaders(map[string]string{
+		"tenant_id":   "acme",
+		"environment": "staging",
+		"cost-center": "42",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := map[string]string{
+		"X-Archestra-Context-Tenant-Id":   "acme",
+		"X-Archestra-Context-Environment": "staging",
+		"X-Archestra-Context-Cost-Center": "42",
+	}
+	if !reflect.DeepEqual(headers, expected) {
+		t.Errorf("Expected headers %v, got %v", expected, headers)
+	}
+}
+
+func TestRequestContextHeaders_CollidingKeys(t *testing.T) {
+	for _, keys := range [][2]string{
+		{"tenant_id", "Tenant-Id"},
+		{"tenant_id", "tenant-id"},
+		{"TENANT_ID", "tenant_id"},
+	} {
+		_, err := requestContextHeaders(map[string]string{keys[0]: "a", keys[1]: "b"})
+		if err == nil {
+			t.Errorf("Expected an error for colliding keys %q and %q", keys[0], keys[1])
+		}
+	}
+}
+
+func TestProviderConfigure_RequestContextCollision(t *testing.T) {
+	t.Setenv("ARCHESTRA_API_KEY", "test-key")
+	t.Setenv("ARCHESTRA_CONFIG", "")
+
+	_, resp := configureTestProvider(t, map[string]any{
+		"request_context": map[string]string{"tenant_id": "acme", "Tenant-Id": "other"},
+	})
+	if !resp.Diagnostics.HasError() {
+		t.Fatal("Expected an error for colliding request_context keys")
+	}
+	if resp.DataSourceData != nil {
+		t.Error("Expected the provider not to be configured")
+	}
+}
+
+func TestProviderConfigure_RequestContextPrecedence(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Clone()
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	t.Setenv("ARCHESTRA_API_KEY", "test-key")
+	t.Setenv("ARCHESTRA_CONFIG", "")
+	t.Setenv("ARCHESTRA_EXTRA_HEADERS", "")
+
+	apiClient, resp := configureTestProvider(t, map[string]any{
+		"base_url": server.URL,
+		"request_context": map[string]string{
+			"tenant_id":   "acme",
+			"environment": "staging",
+		},
+		// An explicit header wins over the same header derived from request_context
+		"extra_headers": map[string]string{
+			"X-Archestra-Context-Environment": "production",
+		},
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	if _, err := apiClient.GetOrganizationWithResponse(t.Context()); err != nil {
+		t.Fatalf("Unexpected error calling the API: %s", err)
+	}
+
+	if got := received.Get("X-Archestra-Context-Tenant-Id"); got != "acme" {
+		t.Errorf("Expected the tenant id from request_context, got %q", got)
+	}
+	if got := received.Get("X-Archestra-Context-Environment"); got != "production" {
+		t.Errorf("Expected extra_headers to take precedence over request_context, got %q", got)
+	}
+	if got := received.Get("Authorization"); got != "test-key" {
+		t.Errorf("Expected the Authorization header to be kept, got %q", got)
+	}
+}
+
+func TestProviderConfigure_PageSize(t *testing.T) {
+	t.Setenv("ARCHESTRA_API_KEY", "test-key")
+	t.Setenv("ARCHESTRA_CONFIG", "")
+
+	_, resp := configureTestProvider(t, nil)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if data := resp.DataSourceData.(*ArchestraDataSourceData); data.PageSize != 0 {
+		t.Errorf("Expected the API default page size (0), got %d", data.PageSize)
+	}
+
+	_, resp = configureTestProvider(t, map[string]any{"page_size": int64(50)})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if data := resp.DataSourceData.(*ArchestraDataSourceData); data.PageSize != 50 {
+		t.Errorf("Expected page size 50, got %d", data.PageSize)
+	}
+}
@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+)
+
+const (
+	rateLimitRemainingHeader = "X-RateLimit-Remaining"
+	rateLimitResetHeader     = "X-RateLimit-Reset"
+)
+
+// rateLimitStatus is the latest rate limit state reported by the API. Fields
+// are nil until a response carrying the corresponding header is seen.
+type rateLimitStatus struct {
+	Remaining *int64
+	Reset     *string
+}
+
+// rateLimitTracker records the rate limit headers of API responses. It is
+// shared by every request of a configured provider.
+type rateLimitTracker struct {
+	mu     sync.Mutex
+	latest rateLimitStatus
+}
+
+// observe updates the tracker from the headers of a response. Responses
+// without rate limit headers leave the previous values in place.
+func (t *rateLimitTracker) observe(header http.Header) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if value := header.Get(rateLimitRemainingHeader); value != "" {
+		if remaining, err := strconv.ParseInt(value, 10, 64); err == nil {
+			t.latest.Remaining = &remaining
+		}
+	}
+	if value := header.Get(rateLimitResetHeader); value != "" {
+		t.latest.Reset = &value
+	}
+}
+
+// status returns a copy of the latest rate limit state.
+func (t *rateLimitTracker) status() rateLimitStatus {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	return t.latest
+}
+
+// rateLimitRecorder is an HTTP client that records the rate limit headers of
+// every response in tracker.
+type rateLimitRecorder struct {
+	next    client.HttpRequestDoer
+	tracker *rateLimitTracker
+}
+
+func (r *rateLimitRecorder) Do(req *http.Request) (*http.Response, error) {
+	resp, err := r.next.Do(req)
+	if resp != nil {
+		r.tracker.observe(resp.Header)
+	}
+	return resp, err
+}
@@ -0,0 +1,73 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+)
+
+func TestRateLimitRecorder_RecordsHeaders(t *testing.T) {
+	withHeaders := true
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if withHeaders {
+			w.Header().Set("X-RateLimit-Remaining", "42")
+			w.Header().Set("X-RateLimit-Reset", "1767225600")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"name":"archestra","status":"ok","version":"1.0.0"}`))
+	}))
+	defer server.Close()
+
+	tracker := &rateLimitTracker{}
+	apiClient, err := client.NewClientWithResponses(
+		server.URL,
+		client.WithHTTPClient(&rateLimitRecorder{next: http.DefaultClient, tracker: tracker}),
+	)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	if status := tracker.status(); status.Remaining != nil || status.Reset != nil {
+		t.Fatalf("Expected no rate limit values before any request, got %+v", status)
+	}
+
+	if _, err := apiClient.GetHealthWithResponse(t.Context()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	status := tracker.status()
+	if status.Remaining == nil || *status.Remaining != 42 {
+		t.Errorf("Expected 42 remaining requests, got %v", status.Remaining)
+	}
+	if status.Reset == nil || *status.Reset != "1767225600" {
+		t.Errorf("Expected reset 1767225600, got %v", status.Reset)
+	}
+
+	// Responses without rate limit headers keep the latest values
+	withHeaders = false
+	if _, err := apiClient.GetHealthWithResponse(t.Context()); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	status = tracker.status()
+	if status.Remaining == nil || *status.Remaining != 42 {
+		t.Errorf("Expected 42 remaining requests to be kept, got %v", status.Remaining)
+	}
+}
+
+func TestRateLimitTracker_IgnoresInvalidRemaining(t *testing.T) {
+	tracker := &rateLimitTracker{}
+
+	header := http.Header{}
+	header.Set("X-RateLimit-Remaining", "10")
+	tracker.observe(header)
+
+	header.Set("X-RateLimit-Remaining", "soon")
+	tracker.observe(header)
+
+	if status := tracker.status(); status.Remaining == nil || *status.Remaining != 10 {
+		t.Errorf("Expected the last valid value 10, got %v", status.Remaining)
+	}
+}
@@ -25,7 +25,9 @@ func NewAgentResource() resource.Resource {
 
 // AgentResource defines the resource implementation.
 type AgentResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
 }
 
 // AgentLabelModel describes a label data model.
@@ -86,21 +88,26 @@ func (r *AgentResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
 }
 
 func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data AgentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -166,6 +173,9 @@ func (r *AgentResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data AgentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -190,7 +200,7 @@ func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	// Handle not found
 	if apiResp.JSON404 != nil {
-		resp.State.RemoveResource(ctx)
+		handleMissingResource(ctx, resp, r.onMissing, "Agent", data.ID.ValueString())
 		return
 	}
 
@@ -216,6 +226,9 @@ func (r *AgentResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
 	var data AgentResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -284,6 +297,9 @@ func (r *AgentResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *AgentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
 	var data AgentResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -306,14 +322,7 @@ func (r *AgentResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	// Check response (200 or 404 are both acceptable for delete)
-	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected API Response",
-			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
-		)
-		return
-	}
+	handleDelete(resp, apiResp)
 }
 
 func (r *AgentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
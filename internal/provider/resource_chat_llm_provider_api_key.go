@@ -7,6 +7,7 @@ import (
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,7 +26,9 @@ func NewChatLLMProviderApiKeyResource() resource.Resource {
 }
 
 type ChatLLMProviderApiKeyResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
 }
 
 type ChatLLMProviderApiKeyResourceModel struct {
@@ -34,6 +37,7 @@ type ChatLLMProviderApiKeyResourceModel struct {
 	ApiKey                types.String `tfsdk:"api_key"`
 	LLMProvider           types.String `tfsdk:"llm_provider"`
 	IsOrganizationDefault types.Bool   `tfsdk:"is_organization_default"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (r *ChatLLMProviderApiKeyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -81,6 +85,14 @@ func (r *ChatLLMProviderApiKeyResource) Schema(ctx context.Context, req resource
 				Computed:            true,
 				Default:             booldefault.StaticBool(false),
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "If true, creating this resource adopts an existing API key instead of creating a new one. " +
+					"Only a key with the same `name` and `llm_provider` is adopted; if none exists, a new key is created. " +
+					"The adopted key's name, stored API key value and default status are overwritten with the configured values. Defaults to `false`.",
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -90,19 +102,24 @@ func (r *ChatLLMProviderApiKeyResource) Configure(ctx context.Context, req resou
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
 }
 
 func (r *ChatLLMProviderApiKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data ChatLLMProviderApiKeyResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -111,6 +128,28 @@ func (r *ChatLLMProviderApiKeyResource) Create(ctx context.Context, req resource
 		return
 	}
 
+	if data.AdoptExisting.ValueBool() {
+		existing, err := r.findExisting(ctx, &data)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to look up existing chat LLM provider API keys, got error: %s", err))
+			return
+		}
+
+		if existing != nil {
+			resp.Diagnostics.AddWarning(
+				"Adopted Existing Chat LLM Provider API Key",
+				fmt.Sprintf("Adopted existing chat LLM provider API key %s (%q). Its name, stored API key value and default status have been overwritten with the configured values.", existing.Id, existing.Name),
+			)
+			r.adopt(ctx, existing.Id, existing.IsOrganizationDefault, &data, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
 	isDefault := data.IsOrganizationDefault.ValueBool()
 	requestBody := client.CreateChatApiKeyJSONRequestBody{
 		Name:                  data.Name.ValueString(),
@@ -142,6 +181,9 @@ func (r *ChatLLMProviderApiKeyResource) Create(ctx context.Context, req resource
 }
 
 func (r *ChatLLMProviderApiKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data ChatLLMProviderApiKeyResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -163,7 +205,7 @@ func (r *ChatLLMProviderApiKeyResource) Read(ctx context.Context, req resource.R
 	}
 
 	if apiResp.JSON404 != nil {
-		resp.State.RemoveResource(ctx)
+		handleMissingResource(ctx, resp, r.onMissing, "Chat LLM provider API key", data.ID.ValueString())
 		return
 	}
 
@@ -183,6 +225,9 @@ func (r *ChatLLMProviderApiKeyResource) Read(ctx context.Context, req resource.R
 }
 
 func (r *ChatLLMProviderApiKeyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
 	var data ChatLLMProviderApiKeyResourceModel
 	var state ChatLLMProviderApiKeyResourceModel
 
@@ -272,6 +317,9 @@ func (r *ChatLLMProviderApiKeyResource) Update(ctx context.Context, req resource
 }
 
 func (r *ChatLLMProviderApiKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
 	var data ChatLLMProviderApiKeyResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -292,15 +340,108 @@ func (r *ChatLLMProviderApiKeyResource) Delete(ctx context.Context, req resource
 		return
 	}
 
-	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
-		resp.Diagnostics.AddError(
+	handleDelete(resp, apiResp)
+}
+
+func (r *ChatLLMProviderApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+}
+
+// chatApiKeyMatch identifies an existing API key found for adoption.
+type chatApiKeyMatch struct {
+	Id                    uuid.UUID
+	Name                  string
+	IsOrganizationDefault bool
+}
+
+// findExisting looks for an API key with the same name and provider that
+// this resource should adopt, or nil if none exists.
+func (r *ChatLLMProviderApiKeyResource) findExisting(ctx context.Context, data *ChatLLMProviderApiKeyResourceModel) (*chatApiKeyMatch, error) {
+	apiResp, err := r.client.GetChatApiKeysWithResponse(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.JSON200 == nil {
+		return nil, fmt.Errorf("expected 200 OK, got status %d", apiResp.StatusCode())
+	}
+
+	for _, key := range *apiResp.JSON200 {
+		if string(key.Provider) == data.LLMProvider.ValueString() && key.Name == data.Name.ValueString() {
+			return &chatApiKeyMatch{Id: key.Id, Name: key.Name, IsOrganizationDefault: key.IsOrganizationDefault}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// adopt brings an existing API key in line with the plan and maps the
+// resulting server state onto data.
+func (r *ChatLLMProviderApiKeyResource) adopt(ctx context.Context, id uuid.UUID, isDefault bool, data *ChatLLMProviderApiKeyResourceModel, diags *diag.Diagnostics) {
+	name := data.Name.ValueString()
+	apiKey := data.ApiKey.ValueString()
+	updateResp, err := r.client.UpdateChatApiKeyWithResponse(ctx, id, client.UpdateChatApiKeyJSONRequestBody{
+		Name:   &name,
+		ApiKey: &apiKey,
+	})
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to update adopted chat LLM provider API key, got error: %s", err))
+		return
+	}
+
+	if updateResp.JSON200 == nil {
+		diags.AddError(
 			"Unexpected API Response",
-			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", updateResp.StatusCode(), string(updateResp.Body)),
 		)
 		return
 	}
-}
 
-func (r *ChatLLMProviderApiKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	if want := data.IsOrganizationDefault.ValueBool(); want && !isDefault {
+		defaultResp, err := r.client.SetChatApiKeyDefaultWithResponse(ctx, id)
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Unable to set chat LLM provider API key as default, got error: %s", err))
+			return
+		}
+		if defaultResp.JSON200 == nil {
+			diags.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("Expected 200 OK when setting default, got status %d: %s", defaultResp.StatusCode(), string(defaultResp.Body)),
+			)
+			return
+		}
+	} else if !want && isDefault {
+		defaultResp, err := r.client.UnsetChatApiKeyDefaultWithResponse(ctx, id)
+		if err != nil {
+			diags.AddError("API Error", fmt.Sprintf("Unable to unset chat LLM provider API key as default, got error: %s", err))
+			return
+		}
+		if defaultResp.JSON200 == nil {
+			diags.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("Expected 200 OK when unsetting default, got status %d: %s", defaultResp.StatusCode(), string(defaultResp.Body)),
+			)
+			return
+		}
+	}
+
+	readResp, err := r.client.GetChatApiKeyWithResponse(ctx, id)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to read adopted chat LLM provider API key, got error: %s", err))
+		return
+	}
+
+	if readResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK on read after adoption, got status %d", readResp.StatusCode()),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(readResp.JSON200.Id.String())
+	data.Name = types.StringValue(readResp.JSON200.Name)
+	data.LLMProvider = types.StringValue(string(readResp.JSON200.Provider))
+	data.IsOrganizationDefault = types.BoolValue(readResp.JSON200.IsOrganizationDefault)
 }
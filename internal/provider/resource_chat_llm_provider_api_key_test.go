@@ -2,12 +2,117 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+const (
+	testChatApiKeyID        = "11111111-1111-1111-1111-111111111111"
+	testChatApiKeyDefaultID = "22222222-2222-2222-2222-222222222222"
+)
+
+func TestChatLLMProviderApiKeyFindExisting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `[
+			{"id":%[1]q,"name":"Team Key","provider":"anthropic","isOrganizationDefault":false,"organizationId":"org","profiles":[]},
+			{"id":%[2]q,"name":"Default Key","provider":"openai","isOrganizationDefault":true,"organizationId":"org","profiles":[]}
+		]`, testChatApiKeyID, testChatApiKeyDefaultID)
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+	r := &ChatLLMProviderApiKeyResource{client: apiClient}
+
+	tests := []struct {
+		name        string
+		keyName     string
+		llmProvider string
+		isDefault   bool
+		expected    string
+	}{
+		{name: "name and provider match", keyName: "Default Key", llmProvider: "openai", expected: testChatApiKeyDefaultID},
+		{name: "name matches another provider", keyName: "Team Key", llmProvider: "openai"},
+		{name: "organization default with another name", keyName: "New Key", llmProvider: "openai", isDefault: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			match, err := r.findExisting(t.Context(), &ChatLLMProviderApiKeyResourceModel{
+				Name:                  types.StringValue(tt.keyName),
+				LLMProvider:           types.StringValue(tt.llmProvider),
+				IsOrganizationDefault: types.BoolValue(tt.isDefault),
+			})
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if tt.expected == "" {
+				if match != nil {
+					t.Errorf("Expected no match, got %s (%q)", match.Id, match.Name)
+				}
+				return
+			}
+			if match == nil || match.Id.String() != tt.expected {
+				t.Errorf("Expected match %s, got %v", tt.expected, match)
+			}
+		})
+	}
+}
+
+func TestChatLLMProviderApiKeyAdopt_UnsetsDefault(t *testing.T) {
+	var requests []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"id":%q,"name":"Default Key","provider":"openai","isOrganizationDefault":false,"organizationId":"org","profiles":[]}`,
+			testChatApiKeyDefaultID)
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	r := &ChatLLMProviderApiKeyResource{client: apiClient}
+	data := ChatLLMProviderApiKeyResourceModel{
+		Name:                  types.StringValue("Default Key"),
+		ApiKey:                types.StringValue("test-api-key-value"),
+		LLMProvider:           types.StringValue("openai"),
+		IsOrganizationDefault: types.BoolValue(false),
+	}
+
+	var diags diag.Diagnostics
+	r.adopt(t.Context(), uuid.MustParse(testChatApiKeyDefaultID), true, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	expected := []string{
+		"PATCH /api/chat-api-keys/" + testChatApiKeyDefaultID,
+		"POST /api/chat-api-keys/" + testChatApiKeyDefaultID + "/unset-default",
+		"GET /api/chat-api-keys/" + testChatApiKeyDefaultID,
+	}
+	if fmt.Sprint(requests) != fmt.Sprint(expected) {
+		t.Errorf("Expected requests %v, got %v", expected, requests)
+	}
+	if data.ID.ValueString() != testChatApiKeyDefaultID || data.IsOrganizationDefault.ValueBool() {
+		t.Errorf("Expected the adopted key to be mapped onto data, got %v", data)
+	}
+}
+
 func TestAccChatLLMProviderApiKeyResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -90,6 +195,37 @@ func TestAccChatLLMProviderApiKeyResourceInvalidProvider(t *testing.T) {
 	})
 }
 
+func TestAccChatLLMProviderApiKeyResourceAdoptExisting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the key that will later be adopted
+			{
+				Config: testAccChatLLMProviderApiKeyResourceConfig("Adoptable Gemini Key", "gemini", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("archestra_chat_llm_provider_api_key.test", "id"),
+				),
+			},
+			// A second resource with the same name and provider adopts it
+			{
+				Config: testAccChatLLMProviderApiKeyResourceConfig("Adoptable Gemini Key", "gemini", false) + `
+resource "archestra_chat_llm_provider_api_key" "adopted" {
+  name           = "Adoptable Gemini Key"
+  api_key        = "test-api-key-value"
+  llm_provider   = "gemini"
+  adopt_existing = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_chat_llm_provider_api_key.adopted", "id", "archestra_chat_llm_provider_api_key.test", "id"),
+					resource.TestCheckResourceAttr("archestra_chat_llm_provider_api_key.adopted", "adopt_existing", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccChatLLMProviderApiKeyResourceConfig(name string, llmProvider string, isDefault bool) string {
 	return fmt.Sprintf(`
 resource "archestra_chat_llm_provider_api_key" "test" {
@@ -27,7 +27,9 @@ func NewLimitResource() resource.Resource {
 
 // LimitResource defines the resource implementation.
 type LimitResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
 }
 
 // LimitResourceModel describes the resource data model.
@@ -201,19 +203,24 @@ func (r *LimitResource) Configure(ctx context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
 }
 
 func (r *LimitResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data LimitResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -282,6 +289,9 @@ func (r *LimitResource) Create(ctx context.Context, req resource.CreateRequest,
 }
 
 func (r *LimitResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data LimitResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -303,7 +313,7 @@ func (r *LimitResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	if apiResp.JSON404 != nil {
-		resp.State.RemoveResource(ctx)
+		handleMissingResource(ctx, resp, r.onMissing, "Limit", data.ID.ValueString())
 		return
 	}
 
@@ -342,6 +352,9 @@ func (r *LimitResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *LimitResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
 	var data LimitResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -424,6 +437,9 @@ func (r *LimitResource) Update(ctx context.Context, req resource.UpdateRequest,
 }
 
 func (r *LimitResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
 	var data LimitResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -444,13 +460,7 @@ func (r *LimitResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected API Response",
-			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
-		)
-		return
-	}
+	handleDelete(resp, apiResp)
 }
 
 func (r *LimitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -22,7 +22,9 @@ func NewMCPServerResource() resource.Resource {
 }
 
 type MCPServerResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
 }
 
 type MCPServerResourceModel struct {
@@ -30,8 +32,20 @@ type MCPServerResourceModel struct {
 	Name        types.String `tfsdk:"name"`
 	DisplayName types.String `tfsdk:"display_name"`
 	MCPServerID types.String `tfsdk:"mcp_server_id"`
+	Status      types.String `tfsdk:"status"`
+	LastError   types.String `tfsdk:"last_error"`
+	Health      types.String `tfsdk:"health"`
+
+	RedeployTrigger types.String `tfsdk:"redeploy_trigger"`
 }
 
+// MCP server installation health values, derived from the local installation status
+const (
+	mcpServerHealthOK       = "ok"
+	mcpServerHealthDegraded = "degraded"
+	mcpServerHealthError    = "error"
+)
+
 func (r *MCPServerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_mcp_server_installation"
 }
@@ -69,6 +83,24 @@ func (r *MCPServerResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The local installation status reported by the orchestrator (`pending`, `discovering-tools`, `success`, `idle` or `error`)",
+				Computed:            true,
+			},
+			"last_error": schema.StringAttribute{
+				MarkdownDescription: "The last installation error reported by the orchestrator, or null if there is none",
+				Computed:            true,
+			},
+			"redeploy_trigger": schema.StringAttribute{
+				MarkdownDescription: "Arbitrary value that restarts the MCP server whenever it changes, without replacing the installation " +
+					"(e.g. a timestamp or the digest of an updated base image). Removing the value does not restart the server.",
+				Optional: true,
+			},
+			"health": schema.StringAttribute{
+				MarkdownDescription: "Summary of `status` for gating pipelines: `ok` when the server is installed (`success` or `idle`), " +
+					"`degraded` while the installation is in progress (`pending` or `discovering-tools`) and `error` when the installation failed",
+				Computed: true,
+			},
 		},
 	}
 }
@@ -78,19 +110,24 @@ func (r *MCPServerResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
 }
 
 func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data MCPServerResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -132,11 +169,15 @@ func (r *MCPServerResource) Create(ctx context.Context, req resource.CreateReque
 	data.ID = types.StringValue(apiResp.JSON200.Id.String())
 	data.DisplayName = types.StringValue(apiResp.JSON200.Name)
 	data.MCPServerID = types.StringValue(apiResp.JSON200.CatalogId.String())
+	data.setInstallationStatus(string(apiResp.JSON200.LocalInstallationStatus), apiResp.JSON200.LocalInstallationError)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data MCPServerResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -159,7 +200,7 @@ func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// Handle not found
 	if apiResp.JSON404 != nil {
-		resp.State.RemoveResource(ctx)
+		handleMissingResource(ctx, resp, r.onMissing, "MCP server installation", data.ID.ValueString())
 		return
 	}
 
@@ -176,21 +217,78 @@ func (r *MCPServerResource) Read(ctx context.Context, req resource.ReadRequest,
 	// Note: Keep user's configured name, set display_name to the API-returned name
 	data.DisplayName = types.StringValue(apiResp.JSON200.Name)
 	data.MCPServerID = types.StringValue(apiResp.JSON200.CatalogId.String())
+	data.setInstallationStatus(string(apiResp.JSON200.LocalInstallationStatus), apiResp.JSON200.LocalInstallationError)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *MCPServerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// NOTE: The Archestra API does not support updating MCP servers.
-	// Updates will trigger resource replacement (delete + create).
-	// This function should never be called due to RequiresReplace plan modifiers on all attributes.
-	resp.Diagnostics.AddError(
-		"Update Not Supported",
-		"MCP server updates are not supported by the API. This should have triggered a replacement.",
-	)
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
+	// NOTE: The Archestra API does not support updating MCP servers, so all
+	// other attributes trigger a replacement. Only redeploy_trigger is updated
+	// in place, by restarting the server.
+	var data, state MCPServerResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	serverID, err := uuid.Parse(state.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse MCP server ID: %s", err))
+		return
+	}
+
+	if !data.RedeployTrigger.IsNull() && !data.RedeployTrigger.Equal(state.RedeployTrigger) {
+		restartResp, err := r.client.RestartMcpServerWithResponse(ctx, serverID)
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to restart MCP server, got error: %s", err))
+			return
+		}
+
+		if restartResp.JSON200 == nil {
+			resp.Diagnostics.AddError(
+				"Unexpected API Response",
+				fmt.Sprintf("Expected 200 OK, got status %d", restartResp.StatusCode()),
+			)
+			return
+		}
+
+		if !restartResp.JSON200.Success {
+			resp.Diagnostics.AddError("MCP Server Restart Failed", restartResp.JSON200.Message)
+			return
+		}
+	}
+
+	// Refresh the installation status after the restart
+	apiResp, err := r.client.GetMcpServerWithResponse(ctx, serverID)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read MCP server, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	data.DisplayName = types.StringValue(apiResp.JSON200.Name)
+	data.MCPServerID = types.StringValue(apiResp.JSON200.CatalogId.String())
+	data.setInstallationStatus(string(apiResp.JSON200.LocalInstallationStatus), apiResp.JSON200.LocalInstallationError)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
 	var data MCPServerResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -211,16 +309,31 @@ func (r *MCPServerResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	// Check response (200 or 404 are both acceptable for delete)
-	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected API Response",
-			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
-		)
-		return
-	}
+	handleDelete(resp, apiResp)
 }
 
 func (r *MCPServerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+// setInstallationStatus maps the local installation status and error to the
+// status, last_error and health attributes.
+func (m *MCPServerResourceModel) setInstallationStatus(status string, lastError *string) {
+	m.Status = types.StringValue(status)
+	m.LastError = types.StringPointerValue(lastError)
+	m.Health = types.StringValue(mcpServerInstallationHealth(status))
+}
+
+// mcpServerInstallationHealth derives the health of an MCP server installation
+// from its local installation status. Unknown statuses are reported as
+// degraded rather than ok so that pipelines gating on health stay safe.
+func mcpServerInstallationHealth(status string) string {
+	switch status {
+	case "success", "idle":
+		return mcpServerHealthOK
+	case "error":
+		return mcpServerHealthError
+	default:
+		return mcpServerHealthDegraded
+	}
+}
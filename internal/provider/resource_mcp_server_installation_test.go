@@ -2,10 +2,12 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
 )
@@ -58,6 +60,54 @@ func TestAccMCPServerResource(t *testing.T) {
 	})
 }
 
+func TestAccMCPServerResourcePromptOnInstallation(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// API_TOKEN is prompted for on installation
+			{
+				Config: testAccMCPServerResourcePromptConfig(true),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server.test",
+						tfjsonpath.New("local_config").AtMapKey("prompt_on_installation"),
+						knownvalue.SetExact([]knownvalue.Check{
+							knownvalue.StringExact("API_TOKEN"),
+						}),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server.test",
+						tfjsonpath.New("local_config").AtMapKey("environment"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"LOG_LEVEL": knownvalue.StringExact("info"),
+						}),
+					),
+				},
+			},
+			// API_TOKEN is set in the configuration instead
+			{
+				Config: testAccMCPServerResourcePromptConfig(false),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server.test",
+						tfjsonpath.New("local_config").AtMapKey("prompt_on_installation"),
+						knownvalue.Null(),
+					),
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server.test",
+						tfjsonpath.New("local_config").AtMapKey("environment"),
+						knownvalue.MapExact(map[string]knownvalue.Check{
+							"API_TOKEN": knownvalue.StringExact("static-token"),
+							"LOG_LEVEL": knownvalue.StringExact("info"),
+						}),
+					),
+				},
+			},
+		},
+	})
+}
+
 func TestAccMCPServerInstallationResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -79,6 +129,12 @@ func TestAccMCPServerInstallationResource(t *testing.T) {
 						tfjsonpath.New("display_name"),
 						knownvalue.NotNull(),
 					),
+					// health is derived from the installation status
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server_installation.test",
+						tfjsonpath.New("health"),
+						knownvalue.StringRegexp(regexp.MustCompile(`^(ok|degraded|error)$`)),
+					),
 				},
 			},
 			// ImportState testing - skip verify since import doesn't restore the user's name
@@ -86,7 +142,7 @@ func TestAccMCPServerInstallationResource(t *testing.T) {
 				ResourceName:            "archestra_mcp_server_installation.test",
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"name"},
+				ImportStateVerifyIgnore: []string{"name", "status", "last_error", "health"},
 			},
 			// Delete testing automatically occurs in TestCase
 			// Note: Update test removed since name change triggers replacement
@@ -94,6 +150,62 @@ func TestAccMCPServerInstallationResource(t *testing.T) {
 	})
 }
 
+func TestMCPServerInstallationHealth(t *testing.T) {
+	tests := []struct {
+		status string
+		health string
+	}{
+		{"success", "ok"},
+		{"idle", "ok"},
+		{"pending", "degraded"},
+		{"discovering-tools", "degraded"},
+		{"error", "error"},
+		{"", "degraded"},
+		{"some-future-status", "degraded"},
+	}
+
+	for _, tt := range tests {
+		if got := mcpServerInstallationHealth(tt.status); got != tt.health {
+			t.Errorf("mcpServerInstallationHealth(%q) = %q, expected %q", tt.status, got, tt.health)
+		}
+	}
+}
+
+func TestAccMCPServerInstallationResourceRedeployTrigger(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMCPServerInstallationResourceRedeployConfig("v1"),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server_installation.test",
+						tfjsonpath.New("redeploy_trigger"),
+						knownvalue.StringExact("v1"),
+					),
+				},
+			},
+			// Changing the trigger restarts the server in place
+			{
+				Config: testAccMCPServerInstallationResourceRedeployConfig("v2"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("archestra_mcp_server_installation.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(
+						"archestra_mcp_server_installation.test",
+						tfjsonpath.New("redeploy_trigger"),
+						knownvalue.StringExact("v2"),
+					),
+				},
+			},
+		},
+	})
+}
+
 func testAccMCPServerResourceConfig(name string) string {
 	return fmt.Sprintf(`
 resource "archestra_mcp_server" "test" {
@@ -124,6 +236,54 @@ resource "archestra_mcp_server" "test" {
 `, name)
 }
 
+func testAccMCPServerResourcePromptConfig(prompt bool) string {
+	environment := `{
+      LOG_LEVEL = "info"
+    }
+    prompt_on_installation = ["API_TOKEN"]`
+	if !prompt {
+		environment = `{
+      LOG_LEVEL = "info"
+      API_TOKEN = "static-token"
+    }`
+	}
+
+	return fmt.Sprintf(`
+resource "archestra_mcp_server" "test" {
+  name        = "test-prompt-mcp-server"
+  description = "Test MCP server with prompted environment"
+  docs_url    = "https://github.com/example/test-server"
+
+  local_config = {
+    command     = "npx"
+    arguments   = ["-y", "@modelcontextprotocol/server-filesystem", "/tmp"]
+    environment = %s
+  }
+}
+`, environment)
+}
+
+func testAccMCPServerInstallationResourceRedeployConfig(trigger string) string {
+	return fmt.Sprintf(`
+resource "archestra_mcp_server" "dependency" {
+  name        = "test-redeploy-server"
+  description = "Dependency server for redeploy test"
+  docs_url    = "https://github.com/example/dependency-server"
+
+  local_config = {
+    command   = "npx"
+    arguments = ["-y", "@modelcontextprotocol/server-filesystem", "/tmp"]
+  }
+}
+
+resource "archestra_mcp_server_installation" "test" {
+  name             = "test-redeploy-installation"
+  mcp_server_id    = archestra_mcp_server.dependency.id
+  redeploy_trigger = %[1]q
+}
+`, trigger)
+}
+
 func testAccMCPServerInstallationResourceConfig(name string) string {
 	return fmt.Sprintf(`
 # First create an MCP server in the registry
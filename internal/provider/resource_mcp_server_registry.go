@@ -3,15 +3,21 @@ package provider
 import (
 	"context"
 	"fmt"
+	"sort"
+	"time"
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,33 +27,58 @@ import (
 var _ resource.Resource = &MCPServerRegistryResource{}
 var _ resource.ResourceWithImportState = &MCPServerRegistryResource{}
 
+// defaultReadAfterCreateRetries is how many times a newly created catalog item
+// is re-read while it is not yet visible to the API.
+const defaultReadAfterCreateRetries = 3
+
+// Environment ownership modes of an MCP server's local_config.environment
+const (
+	environmentOwnershipExclusive = "exclusive"
+	environmentOwnershipShared    = "shared"
+)
+
+// updateEnvironmentEntry is an environment variable in the update request body.
+type updateEnvironmentEntry = struct {
+	Description          *string                                                               `json:"description,omitempty"`
+	Key                  string                                                                `json:"key"`
+	PromptOnInstallation bool                                                                  `json:"promptOnInstallation"`
+	Required             *bool                                                                 `json:"required,omitempty"`
+	Type                 client.UpdateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentType `json:"type"`
+	Value                *string                                                               `json:"value,omitempty"`
+}
+
 func NewMCPServerRegistryResource() resource.Resource {
 	return &MCPServerRegistryResource{}
 }
 
 type MCPServerRegistryResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
 }
 
 type MCPServerRegistryResourceModel struct {
-	ID                  types.String `tfsdk:"id"`
-	Name                types.String `tfsdk:"name"`
-	Description         types.String `tfsdk:"description"`
-	DocsURL             types.String `tfsdk:"docs_url"`
-	InstallationCommand types.String `tfsdk:"installation_command"`
-	AuthDescription     types.String `tfsdk:"auth_description"`
-	LocalConfig         types.Object `tfsdk:"local_config"`
-	AuthFields          types.List   `tfsdk:"auth_fields"`
+	ID                     types.String `tfsdk:"id"`
+	Name                   types.String `tfsdk:"name"`
+	Description            types.String `tfsdk:"description"`
+	DocsURL                types.String `tfsdk:"docs_url"`
+	InstallationCommand    types.String `tfsdk:"installation_command"`
+	AuthDescription        types.String `tfsdk:"auth_description"`
+	LocalConfig            types.Object `tfsdk:"local_config"`
+	AuthFields             types.List   `tfsdk:"auth_fields"`
+	ReadAfterCreateRetries types.Int64  `tfsdk:"read_after_create_retries"`
+	EnvironmentOwnership   types.String `tfsdk:"environment_ownership"`
 }
 
 type LocalConfigModel struct {
-	Command       types.String `tfsdk:"command"`
-	Arguments     types.List   `tfsdk:"arguments"`
-	Environment   types.Map    `tfsdk:"environment"`
-	DockerImage   types.String `tfsdk:"docker_image"`
-	TransportType types.String `tfsdk:"transport_type"`
-	HTTPPort      types.Int64  `tfsdk:"http_port"`
-	HTTPPath      types.String `tfsdk:"http_path"`
+	Command              types.String `tfsdk:"command"`
+	Arguments            types.List   `tfsdk:"arguments"`
+	Environment          types.Map    `tfsdk:"environment"`
+	PromptOnInstallation types.Set    `tfsdk:"prompt_on_installation"`
+	DockerImage          types.String `tfsdk:"docker_image"`
+	TransportType        types.String `tfsdk:"transport_type"`
+	HTTPPort             types.Int64  `tfsdk:"http_port"`
+	HTTPPath             types.String `tfsdk:"http_path"`
 }
 
 type AuthFieldModel struct {
@@ -112,6 +143,13 @@ func (r *MCPServerRegistryResource) Schema(ctx context.Context, req resource.Sch
 						Optional:            true,
 						ElementType:         types.StringType,
 					},
+					"prompt_on_installation": schema.SetAttribute{
+						MarkdownDescription: "Environment variable keys whose values are prompted for when the MCP server is installed. " +
+							"A prompted key may also have a default value in `environment`. A prompted key without a value is not an error: " +
+							"it is sent as required, so the installer must supply a value.",
+						Optional:    true,
+						ElementType: types.StringType,
+					},
 					"docker_image": schema.StringAttribute{
 						MarkdownDescription: "Custom Docker image URL. If not specified, Archestra's default base image will be used.",
 						Optional:            true,
@@ -133,6 +171,27 @@ func (r *MCPServerRegistryResource) Schema(ctx context.Context, req resource.Sch
 					},
 				},
 			},
+			"read_after_create_retries": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Number of times to retry reading the MCP server right after creation while the API still reports it as not found (replication lag). Set to 0 to skip reading it back after creation. Defaults to %d.", defaultReadAfterCreateRetries),
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(defaultReadAfterCreateRetries),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 30),
+				},
+			},
+			"environment_ownership": schema.StringAttribute{
+				MarkdownDescription: "How `local_config.environment` is reconciled with the MCP server: " +
+					"`exclusive` replaces the whole environment with the configured variables, " +
+					"`shared` only adds, updates and removes the variables managed by Terraform and keeps variables set outside Terraform. " +
+					"Defaults to `exclusive`.",
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(environmentOwnershipExclusive),
+				Validators: []validator.String{
+					stringvalidator.OneOf(environmentOwnershipExclusive, environmentOwnershipShared),
+				},
+			},
 			"auth_fields": schema.ListNestedAttribute{
 				MarkdownDescription: "Custom authentication fields required by the MCP server",
 				Optional:            true,
@@ -170,19 +229,24 @@ func (r *MCPServerRegistryResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
 }
 
 func (r *MCPServerRegistryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data MCPServerRegistryResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -255,13 +319,20 @@ func (r *MCPServerRegistryResource) Create(ctx context.Context, req resource.Cre
 			lcStruct.Arguments = &args
 		}
 
-		// Environment - convert map[string]string to new struct format
-		if !localConfig.Environment.IsNull() {
+		// Environment - convert map[string]string and the prompted keys to new struct format
+		if !localConfig.Environment.IsNull() || !localConfig.PromptOnInstallation.IsNull() {
 			var env map[string]string
-			resp.Diagnostics.Append(localConfig.Environment.ElementsAs(ctx, &env, false)...)
+			if !localConfig.Environment.IsNull() {
+				resp.Diagnostics.Append(localConfig.Environment.ElementsAs(ctx, &env, false)...)
+			}
+			var prompted []string
+			if !localConfig.PromptOnInstallation.IsNull() {
+				resp.Diagnostics.Append(localConfig.PromptOnInstallation.ElementsAs(ctx, &prompted, false)...)
+			}
 			if resp.Diagnostics.HasError() {
 				return
 			}
+			entries := environmentEntries(env, prompted)
 			envSlice := make([]struct {
 				Description          *string                                                               `json:"description,omitempty"`
 				Key                  string                                                                `json:"key"`
@@ -269,21 +340,13 @@ func (r *MCPServerRegistryResource) Create(ctx context.Context, req resource.Cre
 				Required             *bool                                                                 `json:"required,omitempty"`
 				Type                 client.CreateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentType `json:"type"`
 				Value                *string                                                               `json:"value,omitempty"`
-			}, 0, len(env))
-			for k, v := range env {
-				val := v
-				envSlice = append(envSlice, struct {
-					Description          *string                                                               `json:"description,omitempty"`
-					Key                  string                                                                `json:"key"`
-					PromptOnInstallation bool                                                                  `json:"promptOnInstallation"`
-					Required             *bool                                                                 `json:"required,omitempty"`
-					Type                 client.CreateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentType `json:"type"`
-					Value                *string                                                               `json:"value,omitempty"`
-				}{
-					Key:   k,
-					Value: &val,
-					Type:  "string",
-				})
+			}, len(entries))
+			for i, entry := range entries {
+				envSlice[i].Key = entry.Key
+				envSlice[i].Value = entry.Value
+				envSlice[i].PromptOnInstallation = entry.PromptOnInstallation
+				envSlice[i].Required = entry.Required
+				envSlice[i].Type = client.CreateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentTypePlainText
 			}
 			lcStruct.Environment = &envSlice
 		}
@@ -355,14 +418,38 @@ func (r *MCPServerRegistryResource) Create(ctx context.Context, req resource.Cre
 		return
 	}
 
-	// Map response to Terraform state
+	// Map response to Terraform state. The item exists from here on, so
+	// failures below must not keep it out of state.
 	data.ID = types.StringValue(apiResp.JSON200.Id.String())
 	data.Name = types.StringValue(apiResp.JSON200.Name)
 
+	// Wait for the new item to become readable so that the refresh following
+	// this apply does not drop it from state as if it had been deleted.
+	if retries := int(data.ReadAfterCreateRetries.ValueInt64()); retries > 0 {
+		retryConfig := readAfterCreateRetryConfig(retries)
+		visible, err := waitForMCPCatalogItem(ctx, r.client, apiResp.JSON200.Id, retryConfig)
+		if err != nil {
+			resp.Diagnostics.AddWarning(
+				"MCP Server Not Yet Readable",
+				fmt.Sprintf("MCP server %s was created but reading it back failed: %s. "+
+					"It has been saved to state; the next refresh will read it again.", apiResp.JSON200.Id, err),
+			)
+		} else if !visible {
+			resp.Diagnostics.AddWarning(
+				"MCP Server Not Yet Visible",
+				fmt.Sprintf("MCP server %s was created but was still not found after %d read attempts. "+
+					"If the next refresh removes it from state, increase read_after_create_retries.", apiResp.JSON200.Id, retryConfig.MaxRetries),
+			)
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *MCPServerRegistryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data MCPServerRegistryResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -385,7 +472,7 @@ func (r *MCPServerRegistryResource) Read(ctx context.Context, req resource.ReadR
 
 	// Handle not found
 	if apiResp.JSON404 != nil {
-		resp.State.RemoveResource(ctx)
+		handleMissingResource(ctx, resp, r.onMissing, "MCP server", data.ID.ValueString())
 		return
 	}
 
@@ -398,6 +485,15 @@ func (r *MCPServerRegistryResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
+	// In shared mode only the environment variables managed by Terraform are
+	// tracked, so that variables set outside Terraform do not show as drift.
+	shared := data.EnvironmentOwnership.ValueString() == environmentOwnershipShared
+	managedEnv, diags := localConfigEnvironment(ctx, data.LocalConfig)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Map response to Terraform state
 	data.Name = types.StringValue(apiResp.JSON200.Name)
 
@@ -428,13 +524,14 @@ func (r *MCPServerRegistryResource) Read(ctx context.Context, req resource.ReadR
 	// Map LocalConfig from API response if present
 	if apiResp.JSON200.LocalConfig != nil {
 		localConfigObj := map[string]attr.Value{
-			"command":        types.StringNull(),
-			"arguments":      types.ListNull(types.StringType),
-			"environment":    types.MapNull(types.StringType),
-			"docker_image":   types.StringNull(),
-			"transport_type": types.StringNull(),
-			"http_port":      types.Int64Null(),
-			"http_path":      types.StringNull(),
+			"command":                types.StringNull(),
+			"arguments":              types.ListNull(types.StringType),
+			"environment":            types.MapNull(types.StringType),
+			"prompt_on_installation": types.SetNull(types.StringType),
+			"docker_image":           types.StringNull(),
+			"transport_type":         types.StringNull(),
+			"http_port":              types.Int64Null(),
+			"http_path":              types.StringNull(),
 		}
 
 		// Command
@@ -454,14 +551,30 @@ func (r *MCPServerRegistryResource) Read(ctx context.Context, req resource.ReadR
 		// Environment
 		if apiResp.JSON200.LocalConfig.Environment != nil && len(*apiResp.JSON200.LocalConfig.Environment) > 0 {
 			envMap := make(map[string]attr.Value)
+			var promptedValues []attr.Value
 			for _, envVar := range *apiResp.JSON200.LocalConfig.Environment {
+				if _, managed := managedEnv[envVar.Key]; shared && !managed {
+					continue
+				}
+				if envVar.PromptOnInstallation {
+					promptedValues = append(promptedValues, types.StringValue(envVar.Key))
+					// Prompted keys without a default value are only listed in prompt_on_installation
+					if envVar.Value == nil {
+						continue
+					}
+				}
 				if envVar.Value != nil {
 					envMap[envVar.Key] = types.StringValue(*envVar.Value)
 				} else {
 					envMap[envVar.Key] = types.StringValue("")
 				}
 			}
-			localConfigObj["environment"], _ = types.MapValue(types.StringType, envMap)
+			if len(envMap) > 0 {
+				localConfigObj["environment"], _ = types.MapValue(types.StringType, envMap)
+			}
+			if len(promptedValues) > 0 {
+				localConfigObj["prompt_on_installation"], _ = types.SetValue(types.StringType, promptedValues)
+			}
 		}
 
 		// Optional fields
@@ -479,25 +592,27 @@ func (r *MCPServerRegistryResource) Read(ctx context.Context, req resource.ReadR
 		}
 
 		localConfigAttrTypes := map[string]attr.Type{
-			"command":        types.StringType,
-			"arguments":      types.ListType{ElemType: types.StringType},
-			"environment":    types.MapType{ElemType: types.StringType},
-			"docker_image":   types.StringType,
-			"transport_type": types.StringType,
-			"http_port":      types.Int64Type,
-			"http_path":      types.StringType,
+			"command":                types.StringType,
+			"arguments":              types.ListType{ElemType: types.StringType},
+			"environment":            types.MapType{ElemType: types.StringType},
+			"prompt_on_installation": types.SetType{ElemType: types.StringType},
+			"docker_image":           types.StringType,
+			"transport_type":         types.StringType,
+			"http_port":              types.Int64Type,
+			"http_path":              types.StringType,
 		}
 
 		data.LocalConfig, _ = types.ObjectValue(localConfigAttrTypes, localConfigObj)
 	} else {
 		data.LocalConfig = types.ObjectNull(map[string]attr.Type{
-			"command":        types.StringType,
-			"arguments":      types.ListType{ElemType: types.StringType},
-			"environment":    types.MapType{ElemType: types.StringType},
-			"docker_image":   types.StringType,
-			"transport_type": types.StringType,
-			"http_port":      types.Int64Type,
-			"http_path":      types.StringType,
+			"command":                types.StringType,
+			"arguments":              types.ListType{ElemType: types.StringType},
+			"environment":            types.MapType{ElemType: types.StringType},
+			"prompt_on_installation": types.SetType{ElemType: types.StringType},
+			"docker_image":           types.StringType,
+			"transport_type":         types.StringType,
+			"http_port":              types.Int64Type,
+			"http_path":              types.StringType,
 		})
 	}
 
@@ -540,6 +655,9 @@ func (r *MCPServerRegistryResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *MCPServerRegistryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
 	var data MCPServerRegistryResourceModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -620,13 +738,20 @@ func (r *MCPServerRegistryResource) Update(ctx context.Context, req resource.Upd
 			lcStruct.Arguments = &args
 		}
 
-		// Environment - convert map[string]string to new struct format
-		if !localConfig.Environment.IsNull() {
+		// Environment - convert map[string]string and the prompted keys to new struct format
+		if !localConfig.Environment.IsNull() || !localConfig.PromptOnInstallation.IsNull() {
 			var env map[string]string
-			resp.Diagnostics.Append(localConfig.Environment.ElementsAs(ctx, &env, false)...)
+			if !localConfig.Environment.IsNull() {
+				resp.Diagnostics.Append(localConfig.Environment.ElementsAs(ctx, &env, false)...)
+			}
+			var prompted []string
+			if !localConfig.PromptOnInstallation.IsNull() {
+				resp.Diagnostics.Append(localConfig.PromptOnInstallation.ElementsAs(ctx, &prompted, false)...)
+			}
 			if resp.Diagnostics.HasError() {
 				return
 			}
+			entries := environmentEntries(env, prompted)
 			envSlice := make([]struct {
 				Description          *string                                                               `json:"description,omitempty"`
 				Key                  string                                                                `json:"key"`
@@ -634,25 +759,45 @@ func (r *MCPServerRegistryResource) Update(ctx context.Context, req resource.Upd
 				Required             *bool                                                                 `json:"required,omitempty"`
 				Type                 client.UpdateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentType `json:"type"`
 				Value                *string                                                               `json:"value,omitempty"`
-			}, 0, len(env))
-			for k, v := range env {
-				val := v
-				envSlice = append(envSlice, struct {
-					Description          *string                                                               `json:"description,omitempty"`
-					Key                  string                                                                `json:"key"`
-					PromptOnInstallation bool                                                                  `json:"promptOnInstallation"`
-					Required             *bool                                                                 `json:"required,omitempty"`
-					Type                 client.UpdateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentType `json:"type"`
-					Value                *string                                                               `json:"value,omitempty"`
-				}{
-					Key:   k,
-					Value: &val,
-					Type:  "string",
-				})
+			}, len(entries))
+			for i, entry := range entries {
+				envSlice[i].Key = entry.Key
+				envSlice[i].Value = entry.Value
+				envSlice[i].PromptOnInstallation = entry.PromptOnInstallation
+				envSlice[i].Required = entry.Required
+				envSlice[i].Type = client.UpdateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentTypePlainText
 			}
 			lcStruct.Environment = &envSlice
 		}
 
+		// In shared mode, keep the environment variables set outside Terraform
+		if data.EnvironmentOwnership.ValueString() == environmentOwnershipShared {
+			var prior MCPServerRegistryResourceModel
+			resp.Diagnostics.Append(req.State.Get(ctx, &prior)...)
+			priorEnv, diags := localConfigEnvironment(ctx, prior.LocalConfig)
+			resp.Diagnostics.Append(diags...)
+			plannedEnv, diags := localConfigEnvironment(ctx, data.LocalConfig)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			unmanaged, err := unmanagedMCPEnvironment(ctx, r.client, serverID, priorEnv, plannedEnv)
+			if err != nil {
+				resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read MCP server environment, got error: %s", err))
+				return
+			}
+
+			if len(unmanaged) > 0 {
+				var envSlice []updateEnvironmentEntry
+				if lcStruct.Environment != nil {
+					envSlice = *lcStruct.Environment
+				}
+				envSlice = append(envSlice, unmanaged...)
+				lcStruct.Environment = &envSlice
+			}
+		}
+
 		// Optional fields
 		if !localConfig.DockerImage.IsNull() {
 			img := localConfig.DockerImage.ValueString()
@@ -732,6 +877,9 @@ func (r *MCPServerRegistryResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *MCPServerRegistryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
 	var data MCPServerRegistryResourceModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -752,16 +900,171 @@ func (r *MCPServerRegistryResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	// Check response (200 or 404 are both acceptable for delete)
-	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected API Response",
-			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
-		)
-		return
-	}
+	handleDelete(resp, apiResp)
 }
 
 func (r *MCPServerRegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("read_after_create_retries"), int64(defaultReadAfterCreateRetries))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("environment_ownership"), environmentOwnershipExclusive)...)
+}
+
+// readAfterCreateRetryConfig returns the retry configuration for reading a
+// catalog item right after it was created. The first read is not a retry, so
+// the number of attempts is one more than retries.
+func readAfterCreateRetryConfig(retries int) RetryConfig {
+	return RetryConfig{
+		MaxRetries:     retries + 1,
+		InitialBackoff: 500 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		Description:    "MCP server",
+	}
+}
+
+// waitForMCPCatalogItem re-reads the catalog item with the given ID until the
+// API stops returning 404 Not Found, or the retries are exhausted.
+func waitForMCPCatalogItem(ctx context.Context, c *client.ClientWithResponses, id uuid.UUID, config RetryConfig) (bool, error) {
+	_, found, err := RetryUntilFound(ctx, config, func() (struct{}, bool, error) {
+		apiResp, err := c.GetInternalMcpCatalogItemWithResponse(ctx, id)
+		if err != nil {
+			return struct{}{}, false, err
+		}
+
+		if apiResp.JSON404 != nil {
+			return struct{}{}, false, nil
+		}
+
+		if apiResp.JSON200 == nil {
+			return struct{}{}, false, fmt.Errorf("expected 200 OK, got status %d", apiResp.StatusCode())
+		}
+
+		return struct{}{}, true, nil
+	})
+
+	return found, err
+}
+
+// localConfigEnvironment returns the environment variables of a local_config
+// object, including prompted keys without a value, or an empty map if it is
+// null.
+func localConfigEnvironment(ctx context.Context, localConfig types.Object) (map[string]string, diag.Diagnostics) {
+	env := map[string]string{}
+	if localConfig.IsNull() || localConfig.IsUnknown() {
+		return env, nil
+	}
+
+	var model LocalConfigModel
+	diags := localConfig.As(ctx, &model, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return env, diags
+	}
+
+	if !model.Environment.IsNull() && !model.Environment.IsUnknown() {
+		diags.Append(model.Environment.ElementsAs(ctx, &env, false)...)
+	}
+
+	// Prompted keys without a default value are managed too
+	if !model.PromptOnInstallation.IsNull() && !model.PromptOnInstallation.IsUnknown() {
+		var prompted []string
+		diags.Append(model.PromptOnInstallation.ElementsAs(ctx, &prompted, false)...)
+		for _, key := range prompted {
+			if _, ok := env[key]; !ok {
+				env[key] = ""
+			}
+		}
+	}
+
+	return env, diags
+}
+
+// unmanagedMCPEnvironment reads the current environment of the catalog item
+// and returns the variables Terraform does not manage: those neither in the
+// prior state nor in the plan. Variables removed from the configuration are
+// in the prior state, so they are not returned and get deleted. Secret values
+// may come back redacted or empty, so secrets are returned without a value to
+// leave the stored one untouched.
+func unmanagedMCPEnvironment(ctx context.Context, c *client.ClientWithResponses, id uuid.UUID, prior, planned map[string]string) ([]updateEnvironmentEntry, error) {
+	apiResp, err := c.GetInternalMcpCatalogItemWithResponse(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiResp.JSON200 == nil {
+		return nil, fmt.Errorf("expected 200 OK, got status %d", apiResp.StatusCode())
+	}
+
+	if apiResp.JSON200.LocalConfig == nil || apiResp.JSON200.LocalConfig.Environment == nil {
+		return nil, nil
+	}
+
+	var unmanaged []updateEnvironmentEntry
+	for _, envVar := range *apiResp.JSON200.LocalConfig.Environment {
+		if _, ok := planned[envVar.Key]; ok {
+			continue
+		}
+		if _, ok := prior[envVar.Key]; ok {
+			continue
+		}
+
+		entry := updateEnvironmentEntry{
+			Description:          envVar.Description,
+			Key:                  envVar.Key,
+			PromptOnInstallation: envVar.PromptOnInstallation,
+			Required:             envVar.Required,
+			Type:                 client.UpdateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentType(envVar.Type),
+			Value:                envVar.Value,
+		}
+		if entry.Type == client.UpdateInternalMcpCatalogItemJSONBodyLocalConfigEnvironmentTypeSecret {
+			entry.Value = nil
+		}
+		unmanaged = append(unmanaged, entry)
+	}
+
+	return unmanaged, nil
+}
+
+// environmentEntry is an environment variable of the MCP server, independent
+// of the create and update request body types.
+type environmentEntry struct {
+	Key                  string
+	Value                *string
+	PromptOnInstallation bool
+	Required             *bool
+}
+
+// environmentEntries merges the configured environment values and prompted
+// keys into environment entries, sorted by key. A prompted key without a
+// value has nothing to fall back on, so it is marked as required.
+func environmentEntries(env map[string]string, prompted []string) []environmentEntry {
+	isPrompted := make(map[string]bool, len(prompted))
+	for _, key := range prompted {
+		isPrompted[key] = true
+	}
+
+	keys := make([]string, 0, len(env)+len(prompted))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	for _, key := range prompted {
+		if _, ok := env[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+
+	entries := make([]environmentEntry, len(keys))
+	for i, key := range keys {
+		entries[i] = environmentEntry{
+			Key:                  key,
+			PromptOnInstallation: isPrompted[key],
+		}
+		if value, ok := env[key]; ok {
+			entries[i].Value = &value
+		} else {
+			required := true
+			entries[i].Required = &required
+		}
+	}
+
+	return entries
 }
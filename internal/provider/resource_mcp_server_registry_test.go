@@ -0,0 +1,200 @@
+package provider
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+)
+
+// newDelayedCatalogItemServer returns a server that answers catalog item reads
+// with 404 Not Found for the first notFoundCount requests, then 200 OK.
+func newDelayedCatalogItemServer(t *testing.T, id uuid.UUID, notFoundCount int32, requests *int32) *client.ClientWithResponses {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if atomic.AddInt32(requests, 1) <= notFoundCount {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"error":{"message":"Catalog item not found","type":"api_not_found_error"}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"id":"` + id.String() + `","name":"delayed-server"}`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	return apiClient
+}
+
+func testReadAfterCreateRetryConfig(retries int) RetryConfig {
+	config := readAfterCreateRetryConfig(retries)
+	config.InitialBackoff = time.Millisecond
+	config.MaxBackoff = time.Millisecond
+	return config
+}
+
+func TestWaitForMCPCatalogItem_DelayedVisibility(t *testing.T) {
+	id := uuid.New()
+	var requests int32
+	apiClient := newDelayedCatalogItemServer(t, id, 2, &requests)
+
+	visible, err := waitForMCPCatalogItem(t.Context(), apiClient, id, testReadAfterCreateRetryConfig(3))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if !visible {
+		t.Fatal("Expected catalog item to become visible")
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 reads, got %d", requests)
+	}
+}
+
+func TestWaitForMCPCatalogItem_RetriesExhausted(t *testing.T) {
+	id := uuid.New()
+	var requests int32
+	apiClient := newDelayedCatalogItemServer(t, id, 5, &requests)
+
+	visible, err := waitForMCPCatalogItem(t.Context(), apiClient, id, testReadAfterCreateRetryConfig(2))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if visible {
+		t.Fatal("Expected catalog item to still be invisible")
+	}
+	if requests != 3 {
+		t.Errorf("Expected 3 reads, got %d", requests)
+	}
+}
+
+// Create skips the wait entirely when read_after_create_retries is 0; the
+// helper itself always makes at least one read.
+func TestWaitForMCPCatalogItem_SingleAttempt(t *testing.T) {
+	id := uuid.New()
+	var requests int32
+	apiClient := newDelayedCatalogItemServer(t, id, 1, &requests)
+
+	visible, err := waitForMCPCatalogItem(t.Context(), apiClient, id, testReadAfterCreateRetryConfig(0))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if visible {
+		t.Fatal("Expected a single read to see the item as not found")
+	}
+	if requests != 1 {
+		t.Errorf("Expected 1 read, got %d", requests)
+	}
+}
+
+func TestUnmanagedMCPEnvironment_KeepsUnmanagedKeys(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"` + id.String() + `","name":"shared-server","localConfig":{"environment":[
+			{"key":"LOG_LEVEL","type":"plain_text","value":"debug","promptOnInstallation":false},
+			{"key":"OLD_FLAG","type":"plain_text","value":"1","promptOnInstallation":false},
+			{"key":"API_TOKEN","type":"secret","promptOnInstallation":true,"description":"Set by the platform team"}
+		]}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	// LOG_LEVEL is managed by Terraform, OLD_FLAG was removed from the
+	// configuration and API_TOKEN was set outside Terraform.
+	prior := map[string]string{"LOG_LEVEL": "info", "OLD_FLAG": "1"}
+	planned := map[string]string{"LOG_LEVEL": "warn"}
+
+	unmanaged, err := unmanagedMCPEnvironment(t.Context(), apiClient, id, prior, planned)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(unmanaged) != 1 {
+		t.Fatalf("Expected only the unmanaged variable to survive, got %d variables", len(unmanaged))
+	}
+
+	entry := unmanaged[0]
+	if entry.Key != "API_TOKEN" {
+		t.Errorf("Expected API_TOKEN to survive, got %s", entry.Key)
+	}
+	if entry.Type != "secret" || !entry.PromptOnInstallation || entry.Description == nil || *entry.Description != "Set by the platform team" {
+		t.Errorf("Expected the unmanaged variable to be kept as is, got %+v", entry)
+	}
+}
+
+func TestUnmanagedMCPEnvironment_DoesNotResendSecretValues(t *testing.T) {
+	id := uuid.New()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"` + id.String() + `","name":"shared-server","localConfig":{"environment":[
+			{"key":"DB_PASSWORD","type":"secret","promptOnInstallation":false},
+			{"key":"API_TOKEN","type":"secret","value":"********","promptOnInstallation":false},
+			{"key":"REGION","type":"plain_text","value":"eu","promptOnInstallation":false}
+		]}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	unmanaged, err := unmanagedMCPEnvironment(t.Context(), apiClient, id, nil, nil)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(unmanaged) != 3 {
+		t.Fatalf("Expected all 3 unmanaged variables to be kept, got %d", len(unmanaged))
+	}
+
+	for _, entry := range unmanaged {
+		switch entry.Key {
+		case "DB_PASSWORD", "API_TOKEN":
+			if entry.Type != "secret" || entry.Value != nil {
+				t.Errorf("Expected secret %s to be kept without a value, got %+v", entry.Key, entry)
+			}
+		case "REGION":
+			if entry.Value == nil || *entry.Value != "eu" {
+				t.Errorf("Expected REGION to keep its value, got %+v", entry)
+			}
+		}
+	}
+}
+
+func TestEnvironmentEntries(t *testing.T) {
+	entries := environmentEntries(
+		map[string]string{"LOG_LEVEL": "info", "REGION": "eu"},
+		[]string{"API_TOKEN", "REGION"},
+	)
+
+	if len(entries) != 3 {
+		t.Fatalf("Expected 3 entries, got %d", len(entries))
+	}
+
+	// Entries are sorted by key
+	token, logLevel, region := entries[0], entries[1], entries[2]
+
+	if token.Key != "API_TOKEN" || !token.PromptOnInstallation || token.Value != nil || token.Required == nil || !*token.Required {
+		t.Errorf("Expected API_TOKEN to be prompted and required without a value, got %+v", token)
+	}
+	if logLevel.Key != "LOG_LEVEL" || logLevel.PromptOnInstallation || logLevel.Value == nil || *logLevel.Value != "info" || logLevel.Required != nil {
+		t.Errorf("Expected LOG_LEVEL to be a plain value, got %+v", logLevel)
+	}
+	if region.Key != "REGION" || !region.PromptOnInstallation || region.Value == nil || *region.Value != "eu" || region.Required != nil {
+		t.Errorf("Expected REGION to be prompted with a default value, got %+v", region)
+	}
+}
@@ -31,7 +31,9 @@ func NewOptimizationRuleResource() resource.Resource {
 
 // OptimizationRuleResource defines the resource implementation.
 type OptimizationRuleResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
 }
 
 // OptimizationRuleConditionModel represents a single condition.
@@ -120,16 +122,18 @@ func (r *OptimizationRuleResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
 }
 
 // buildConditionsJSON converts Terraform conditions to a slice of JSON-serializable maps.
@@ -161,6 +165,9 @@ func buildConditionsJSON(ctx context.Context, conditionsList types.List) ([]map[
 }
 
 func (r *OptimizationRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data OptimizationRuleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -216,6 +223,9 @@ func (r *OptimizationRuleResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *OptimizationRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data OptimizationRuleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -278,8 +288,8 @@ func (r *OptimizationRuleResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	if !found {
-		tflog.Warn(ctx, fmt.Sprintf("Rule %s not found in API response after retries, removing from state", ruleID))
-		resp.State.RemoveResource(ctx)
+		tflog.Warn(ctx, fmt.Sprintf("Rule %s not found in API response after retries", ruleID))
+		handleMissingResource(ctx, resp, r.onMissing, "Optimization rule", ruleID)
 		return
 	}
 
@@ -294,6 +304,9 @@ func (r *OptimizationRuleResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *OptimizationRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
 	var data OptimizationRuleResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -353,6 +366,9 @@ func (r *OptimizationRuleResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *OptimizationRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
 	var data OptimizationRuleResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -373,13 +389,7 @@ func (r *OptimizationRuleResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected API Response",
-			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
-		)
-		return
-	}
+	handleDelete(resp, apiResp)
 }
 
 func (r *OptimizationRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -6,6 +6,7 @@ import (
 
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -25,7 +26,8 @@ func NewOrganizationSettingsResource() resource.Resource {
 }
 
 type OrganizationSettingsResource struct {
-	client *client.ClientWithResponses
+	client   *client.ClientWithResponses
+	timeouts operationTimeouts
 }
 
 type OrganizationSettingsResourceModel struct {
@@ -166,19 +168,23 @@ func (r *OrganizationSettingsResource) Configure(ctx context.Context, req resour
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.timeouts = data.Timeouts
 }
 
 func (r *OrganizationSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data OrganizationSettingsResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -209,6 +215,9 @@ func (r *OrganizationSettingsResource) Create(ctx context.Context, req resource.
 }
 
 func (r *OrganizationSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data OrganizationSettingsResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -254,9 +263,14 @@ func (r *OrganizationSettingsResource) Read(ctx context.Context, req resource.Re
 }
 
 func (r *OrganizationSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
 	var data OrganizationSettingsResourceModel
+	var state OrganizationSettingsResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -278,8 +292,25 @@ func (r *OrganizationSettingsResource) Update(ctx context.Context, req resource.
 		return
 	}
 
+	planned := data
 	r.mapResponseToModel(&data, apiResp)
 
+	// Once onboarding is complete the server may lock some settings and
+	// silently ignore changes to them. Surface that instead of letting
+	// Terraform report an inconsistent result.
+	if state.OnboardingComplete.ValueBool() {
+		for _, field := range unappliedOrganizationSettings(planned, state, data) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(field),
+				"Organization Setting Locked",
+				fmt.Sprintf("Field %s cannot be changed after onboarding: the server accepted the update but kept the previous value.", field),
+			)
+		}
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -358,3 +389,35 @@ func (r *OrganizationSettingsResource) mapResponseToModel(data *OrganizationSett
 		data.LimitCleanupInterval = types.StringNull()
 	}
 }
+
+// unappliedOrganizationSettings returns the attributes that were changed in
+// the plan relative to the prior state but still differ from the planned value
+// in the applied (server) result.
+func unappliedOrganizationSettings(planned, prior, applied OrganizationSettingsResourceModel) []string {
+	fields := []struct {
+		name    string
+		planned attr.Value
+		prior   attr.Value
+		applied attr.Value
+	}{
+		{"font", planned.Font, prior.Font, applied.Font},
+		{"color_theme", planned.ColorTheme, prior.ColorTheme, applied.ColorTheme},
+		{"logo", planned.Logo, prior.Logo, applied.Logo},
+		{"limit_cleanup_interval", planned.LimitCleanupInterval, prior.LimitCleanupInterval, applied.LimitCleanupInterval},
+		{"compression_scope", planned.CompressionScope, prior.CompressionScope, applied.CompressionScope},
+		{"onboarding_complete", planned.OnboardingComplete, prior.OnboardingComplete, applied.OnboardingComplete},
+		{"convert_tool_results_to_toon", planned.ConvertToolResultsToToon, prior.ConvertToolResultsToToon, applied.ConvertToolResultsToToon},
+	}
+
+	var unapplied []string
+	for _, field := range fields {
+		if field.planned.IsNull() || field.planned.IsUnknown() || field.planned.Equal(field.prior) {
+			continue
+		}
+		if !field.planned.Equal(field.applied) {
+			unapplied = append(unapplied, field.name)
+		}
+	}
+
+	return unapplied
+}
@@ -1,9 +1,11 @@
 package provider
 
 import (
+	"reflect"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
@@ -216,3 +218,49 @@ resource "archestra_organization_settings" "test" {
 }
 `
 }
+
+func testOrganizationSettingsModel(font, colorTheme string) OrganizationSettingsResourceModel {
+	return OrganizationSettingsResourceModel{
+		ID:                       types.StringValue("org-id"),
+		Font:                     types.StringValue(font),
+		ColorTheme:               types.StringValue(colorTheme),
+		Logo:                     types.StringNull(),
+		LimitCleanupInterval:     types.StringNull(),
+		CompressionScope:         types.StringValue("organization"),
+		OnboardingComplete:       types.BoolValue(true),
+		ConvertToolResultsToToon: types.BoolValue(false),
+	}
+}
+
+func TestUnappliedOrganizationSettings_LockedField(t *testing.T) {
+	prior := testOrganizationSettingsModel("inter", "modern-minimal")
+	planned := testOrganizationSettingsModel("roboto", "claude")
+	// The server applied the theme change but kept the previous font.
+	applied := testOrganizationSettingsModel("inter", "claude")
+
+	got := unappliedOrganizationSettings(planned, prior, applied)
+	if want := []string{"font"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestUnappliedOrganizationSettings_AllApplied(t *testing.T) {
+	prior := testOrganizationSettingsModel("inter", "modern-minimal")
+	planned := testOrganizationSettingsModel("roboto", "claude")
+
+	if got := unappliedOrganizationSettings(planned, prior, planned); len(got) != 0 {
+		t.Errorf("Expected no unapplied fields, got %v", got)
+	}
+}
+
+func TestUnappliedOrganizationSettings_UnchangedFieldIgnored(t *testing.T) {
+	prior := testOrganizationSettingsModel("inter", "modern-minimal")
+	planned := testOrganizationSettingsModel("inter", "claude")
+	// A field that was not part of the change is not reported even if the
+	// server returns something else for it.
+	applied := testOrganizationSettingsModel("lato", "claude")
+
+	if got := unappliedOrganizationSettings(planned, prior, applied); len(got) != 0 {
+		t.Errorf("Expected no unapplied fields, got %v", got)
+	}
+}
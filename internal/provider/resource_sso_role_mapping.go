@@ -0,0 +1,393 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &SSORoleMappingResource{}
+var _ resource.ResourceWithImportState = &SSORoleMappingResource{}
+
+func NewSSORoleMappingResource() resource.Resource {
+	return &SSORoleMappingResource{}
+}
+
+// SSORoleMappingResource defines the resource implementation.
+type SSORoleMappingResource struct {
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
+}
+
+// SSORoleMappingResourceModel describes the resource data model.
+type SSORoleMappingResourceModel struct {
+	ID            types.String              `tfsdk:"id"`
+	SSOProviderID types.String              `tfsdk:"sso_provider_id"`
+	DefaultRole   types.String              `tfsdk:"default_role"`
+	StrictMode    types.Bool                `tfsdk:"strict_mode"`
+	SkipRoleSync  types.Bool                `tfsdk:"skip_role_sync"`
+	Rules         []SSORoleMappingRuleModel `tfsdk:"rules"`
+}
+
+// SSORoleMappingRuleModel describes a single role mapping rule.
+type SSORoleMappingRuleModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Role       types.String `tfsdk:"role"`
+}
+
+// ssoRoleMappingRule and ssoRoleMapping alias the anonymous roleMapping
+// structs shared by the SSO provider request and response types.
+type ssoRoleMappingRule = struct {
+	Expression string `json:"expression"`
+	Role       string `json:"role"`
+}
+
+type ssoRoleMapping = struct {
+	DefaultRole  *string               `json:"defaultRole,omitempty"`
+	Rules        *[]ssoRoleMappingRule `json:"rules,omitempty"`
+	SkipRoleSync *bool                 `json:"skipRoleSync,omitempty"`
+	StrictMode   *bool                 `json:"strictMode,omitempty"`
+}
+
+func (r *SSORoleMappingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sso_role_mapping"
+}
+
+func (r *SSORoleMappingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages the role mapping rules of an SSO provider in Archestra, independently of the rest of the provider's configuration. " +
+			"Only the `roleMapping` block of the SSO provider is written, so the provider itself can be owned elsewhere. " +
+			"Creating this resource fails if the SSO provider already has role mapping rules; import it instead. Destroying it clears the rules and keeps the other role mapping settings.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier of this resource, equal to `sso_provider_id`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sso_provider_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the SSO provider whose role mapping is managed",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"default_role": schema.StringAttribute{
+				MarkdownDescription: "Role assigned when no rule matches. Unset when omitted, so the backend default applies",
+				Optional:            true,
+			},
+			"strict_mode": schema.BoolAttribute{
+				MarkdownDescription: "Whether to deny login when no rule matches. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"skip_role_sync": schema.BoolAttribute{
+				MarkdownDescription: "Whether to only assign a role on first login instead of on every login. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"rules": schema.ListNestedAttribute{
+				MarkdownDescription: "Ordered role mapping rules; the first matching rule wins",
+				Required:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"expression": schema.StringAttribute{
+							MarkdownDescription: "Expression evaluated against the identity provider claims",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Role assigned when the expression matches",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SSORoleMappingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	data, ok := req.ProviderData.(*ArchestraResourceData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
+}
+
+func (r *SSORoleMappingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
+	var data SSORoleMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.SSOProviderID.ValueString()
+
+	current, err := r.client.GetSsoProviderWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read SSO provider, got error: %s", err))
+		return
+	}
+
+	if current.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", current.StatusCode()),
+		)
+		return
+	}
+
+	if hasSSORoleMappingRules(current.JSON200.RoleMapping) {
+		resp.Diagnostics.AddError(
+			"Role Mapping Already Managed",
+			fmt.Sprintf("SSO provider %s already has role mapping rules. Import them with `terraform import` instead of creating a second owner, "+
+				"or remove them from the SSO provider's own configuration first.", id),
+		)
+		return
+	}
+
+	r.update(ctx, id, current.JSON200.DomainVerified, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSORoleMappingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
+	var data SSORoleMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	apiResp, err := r.client.GetSsoProviderWithResponse(ctx, data.SSOProviderID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read SSO provider, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON404 != nil {
+		handleMissingResource(ctx, resp, r.onMissing, "SSO role mapping", data.ID.ValueString())
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
+		return
+	}
+
+	setSSORoleMapping(&data, apiResp.JSON200.RoleMapping)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSORoleMappingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
+	var data SSORoleMappingResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.SSOProviderID.ValueString()
+
+	current, err := r.client.GetSsoProviderWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read SSO provider, got error: %s", err))
+		return
+	}
+
+	if current.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", current.StatusCode()),
+		)
+		return
+	}
+
+	r.update(ctx, id, current.JSON200.DomainVerified, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SSORoleMappingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
+	var data SSORoleMappingResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := data.SSOProviderID.ValueString()
+
+	current, err := r.client.GetSsoProviderWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read SSO provider, got error: %s", err))
+		return
+	}
+
+	if current.JSON404 != nil {
+		return
+	}
+
+	if current.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", current.StatusCode()),
+		)
+		return
+	}
+
+	// Only the rules are cleared; the rest of the role mapping and the SSO
+	// provider itself are left in place.
+	apiResp, err := r.client.UpdateSsoProviderWithResponse(ctx, id, client.UpdateSsoProviderJSONRequestBody{
+		DomainVerified: current.JSON200.DomainVerified,
+		RoleMapping:    withoutSSORoleMappingRules(current.JSON200.RoleMapping),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to clear SSO role mapping, got error: %s", err))
+		return
+	}
+
+	handleDelete(resp, apiResp)
+}
+
+func (r *SSORoleMappingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sso_provider_id"), req.ID)...)
+}
+
+// update writes the planned role mapping to the SSO provider with the given
+// ID and maps the response back onto data. domainVerified is always sent by
+// the client, so the current value is carried over to leave it unchanged.
+func (r *SSORoleMappingResource) update(ctx context.Context, id string, domainVerified *bool, data *SSORoleMappingResourceModel, diags *diag.Diagnostics) {
+	requestBody := client.UpdateSsoProviderJSONRequestBody{
+		DomainVerified: domainVerified,
+		RoleMapping:    ssoRoleMappingFromModel(data),
+	}
+
+	apiResp, err := r.client.UpdateSsoProviderWithResponse(ctx, id, requestBody)
+	if err != nil {
+		diags.AddError("API Error", fmt.Sprintf("Unable to update SSO role mapping, got error: %s", err))
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		diags.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d: %s", apiResp.StatusCode(), string(apiResp.Body)),
+		)
+		return
+	}
+
+	data.ID = types.StringValue(id)
+	setSSORoleMapping(data, apiResp.JSON200.RoleMapping)
+}
+
+// ssoRoleMappingFromModel builds the roleMapping request block from data. The
+// block replaces the stored one, so a null default_role unsets it.
+func ssoRoleMappingFromModel(data *SSORoleMappingResourceModel) *ssoRoleMapping {
+	rules := make([]ssoRoleMappingRule, len(data.Rules))
+	for i, rule := range data.Rules {
+		rules[i] = ssoRoleMappingRule{
+			Expression: rule.Expression.ValueString(),
+			Role:       rule.Role.ValueString(),
+		}
+	}
+
+	return &ssoRoleMapping{
+		DefaultRole:  data.DefaultRole.ValueStringPointer(),
+		Rules:        &rules,
+		SkipRoleSync: data.SkipRoleSync.ValueBoolPointer(),
+		StrictMode:   data.StrictMode.ValueBoolPointer(),
+	}
+}
+
+// setSSORoleMapping maps a roleMapping block returned by the API onto data.
+func setSSORoleMapping(data *SSORoleMappingResourceModel, roleMapping *ssoRoleMapping) {
+	if roleMapping == nil {
+		roleMapping = &ssoRoleMapping{}
+	}
+
+	data.DefaultRole = types.StringPointerValue(roleMapping.DefaultRole)
+	data.StrictMode = types.BoolValue(roleMapping.StrictMode != nil && *roleMapping.StrictMode)
+	data.SkipRoleSync = types.BoolValue(roleMapping.SkipRoleSync != nil && *roleMapping.SkipRoleSync)
+
+	data.Rules = []SSORoleMappingRuleModel{}
+	if roleMapping.Rules != nil {
+		for _, rule := range *roleMapping.Rules {
+			data.Rules = append(data.Rules, SSORoleMappingRuleModel{
+				Expression: types.StringValue(rule.Expression),
+				Role:       types.StringValue(rule.Role),
+			})
+		}
+	}
+}
+
+// hasSSORoleMappingRules reports whether roleMapping contains any rules.
+func hasSSORoleMappingRules(roleMapping *ssoRoleMapping) bool {
+	return roleMapping != nil && roleMapping.Rules != nil && len(*roleMapping.Rules) > 0
+}
+
+// withoutSSORoleMappingRules returns a copy of roleMapping with no rules,
+// keeping its other settings.
+func withoutSSORoleMappingRules(roleMapping *ssoRoleMapping) *ssoRoleMapping {
+	cleared := ssoRoleMapping{}
+	if roleMapping != nil {
+		cleared = *roleMapping
+	}
+	cleared.Rules = &[]ssoRoleMappingRule{}
+
+	return &cleared
+}
@@ -0,0 +1,211 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestSSORoleMappingFromModel_OmitsNullDefaultRole(t *testing.T) {
+	roleMapping := ssoRoleMappingFromModel(&SSORoleMappingResourceModel{
+		DefaultRole:  types.StringNull(),
+		StrictMode:   types.BoolValue(true),
+		SkipRoleSync: types.BoolValue(false),
+		Rules: []SSORoleMappingRuleModel{
+			{Expression: types.StringValue(`"admins" in groups`), Role: types.StringValue("admin")},
+		},
+	})
+
+	if roleMapping.DefaultRole != nil {
+		t.Errorf("Expected null default_role to be omitted, got %q", *roleMapping.DefaultRole)
+	}
+	if roleMapping.SkipRoleSync == nil || *roleMapping.SkipRoleSync {
+		t.Errorf("Expected skip_role_sync to be false, got %v", roleMapping.SkipRoleSync)
+	}
+	if roleMapping.StrictMode == nil || !*roleMapping.StrictMode {
+		t.Errorf("Expected strict_mode to be true, got %v", roleMapping.StrictMode)
+	}
+	if roleMapping.Rules == nil || len(*roleMapping.Rules) != 1 || (*roleMapping.Rules)[0].Role != "admin" {
+		t.Errorf("Expected a single admin rule, got %v", roleMapping.Rules)
+	}
+}
+
+func TestSetSSORoleMapping_Nil(t *testing.T) {
+	data := SSORoleMappingResourceModel{
+		Rules: []SSORoleMappingRuleModel{
+			{Expression: types.StringValue("true"), Role: types.StringValue("member")},
+		},
+	}
+
+	setSSORoleMapping(&data, nil)
+
+	if data.Rules == nil || len(data.Rules) != 0 {
+		t.Errorf("Expected an empty rule list, got %v", data.Rules)
+	}
+	if !data.DefaultRole.IsNull() {
+		t.Errorf("Expected default_role to be null, got %s", data.DefaultRole)
+	}
+	if data.StrictMode.ValueBool() || data.SkipRoleSync.ValueBool() {
+		t.Errorf("Expected strict_mode and skip_role_sync to default to false, got %v", data)
+	}
+}
+
+func TestWithoutSSORoleMappingRules_KeepsSettings(t *testing.T) {
+	defaultRole := "member"
+	strictMode := true
+	rules := []ssoRoleMappingRule{{Expression: "true", Role: "admin"}}
+	current := &ssoRoleMapping{DefaultRole: &defaultRole, StrictMode: &strictMode, Rules: &rules}
+
+	cleared := withoutSSORoleMappingRules(current)
+
+	if cleared.Rules == nil || len(*cleared.Rules) != 0 {
+		t.Errorf("Expected rules to be cleared, got %v", cleared.Rules)
+	}
+	if cleared.DefaultRole == nil || *cleared.DefaultRole != "member" {
+		t.Errorf("Expected default role to be kept, got %v", cleared.DefaultRole)
+	}
+	if cleared.StrictMode == nil || !*cleared.StrictMode {
+		t.Errorf("Expected strict mode to be kept, got %v", cleared.StrictMode)
+	}
+	if len(*current.Rules) != 1 {
+		t.Error("Expected the current role mapping to be left unmodified")
+	}
+
+	if cleared := withoutSSORoleMappingRules(nil); cleared.Rules == nil || len(*cleared.Rules) != 0 {
+		t.Errorf("Expected empty rules for a provider without role mapping, got %v", cleared.Rules)
+	}
+}
+
+func TestHasSSORoleMappingRules(t *testing.T) {
+	empty := []ssoRoleMappingRule{}
+	one := []ssoRoleMappingRule{{Expression: "true", Role: "member"}}
+
+	tests := []struct {
+		name        string
+		roleMapping *ssoRoleMapping
+		expected    bool
+	}{
+		{name: "nil", roleMapping: nil, expected: false},
+		{name: "no rules", roleMapping: &ssoRoleMapping{}, expected: false},
+		{name: "empty rules", roleMapping: &ssoRoleMapping{Rules: &empty}, expected: false},
+		{name: "rules", roleMapping: &ssoRoleMapping{Rules: &one}, expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := hasSSORoleMappingRules(tt.roleMapping); got != tt.expected {
+				t.Errorf("Expected %t, got %t", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestSSORoleMappingUpdate_OnlySendsRoleMapping(t *testing.T) {
+	var body map[string]json.RawMessage
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Errorf("Unable to decode request body: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"id":"sso-1","providerId":"okta","domain":"example.com","issuer":"https://example.okta.com",
+			"roleMapping":{"defaultRole":"member","rules":[{"expression":"\"admins\" in groups","role":"admin"}]}}`))
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	r := &SSORoleMappingResource{client: apiClient}
+	verified := true
+	data := SSORoleMappingResourceModel{
+		SSOProviderID: types.StringValue("sso-1"),
+		DefaultRole:   types.StringValue("member"),
+		StrictMode:    types.BoolValue(false),
+		SkipRoleSync:  types.BoolValue(false),
+		Rules: []SSORoleMappingRuleModel{
+			{Expression: types.StringValue(`"admins" in groups`), Role: types.StringValue("admin")},
+		},
+	}
+
+	var diags diag.Diagnostics
+	r.update(t.Context(), "sso-1", &verified, &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if len(body) != 2 || body["roleMapping"] == nil || string(body["domainVerified"]) != "true" {
+		t.Errorf("Expected only roleMapping and domainVerified to be sent, got %v", body)
+	}
+	if data.ID.ValueString() != "sso-1" || len(data.Rules) != 1 || data.Rules[0].Role.ValueString() != "admin" {
+		t.Errorf("Expected response to be mapped onto data, got %v", data)
+	}
+	if data.StrictMode.ValueBool() {
+		t.Errorf("Expected strict_mode to be false, got %s", data.StrictMode)
+	}
+}
+
+func TestAccSSORoleMappingResource(t *testing.T) {
+	ssoProviderID := os.Getenv("ARCHESTRA_TEST_SSO_PROVIDER_ID")
+	if ssoProviderID == "" {
+		t.Skip("Skipping SSO role mapping tests - ARCHESTRA_TEST_SSO_PROVIDER_ID must point at an SSO provider without role mapping rules")
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSSORoleMappingResourceConfig(ssoProviderID, "admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_sso_role_mapping.test", "id", ssoProviderID),
+					resource.TestCheckResourceAttr("archestra_sso_role_mapping.test", "default_role", "member"),
+					resource.TestCheckResourceAttr("archestra_sso_role_mapping.test", "rules.#", "1"),
+					resource.TestCheckResourceAttr("archestra_sso_role_mapping.test", "rules.0.role", "admin"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "archestra_sso_role_mapping.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccSSORoleMappingResourceConfig(ssoProviderID, "editor"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("archestra_sso_role_mapping.test", "rules.0.role", "editor"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSSORoleMappingResourceConfig(ssoProviderID, role string) string {
+	return fmt.Sprintf(`
+resource "archestra_sso_role_mapping" "test" {
+  sso_provider_id = %[1]q
+  default_role    = "member"
+
+  rules = [
+    {
+      expression = "'admins' in groups"
+      role       = %[2]q
+    },
+  ]
+}
+`, ssoProviderID, role)
+}
@@ -22,7 +22,9 @@ func NewTeamExternalGroupResource() resource.Resource {
 }
 
 type TeamExternalGroupResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
 }
 
 type TeamExternalGroupModel struct {
@@ -52,16 +54,18 @@ func (r *TeamExternalGroupResource) Configure(
 		return
 	}
 
-	c, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Provider Data",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got %T", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got %T", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = c
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
 }
 
 /* ---------------- Schema ---------------- */
@@ -104,6 +108,9 @@ func (r *TeamExternalGroupResource) Create(
 	req resource.CreateRequest,
 	resp *resource.CreateResponse,
 ) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data TeamExternalGroupModel
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -145,6 +152,9 @@ func (r *TeamExternalGroupResource) Read(
 	req resource.ReadRequest,
 	resp *resource.ReadResponse,
 ) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data TeamExternalGroupModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -155,8 +165,22 @@ func (r *TeamExternalGroupResource) Read(
 		ctx,
 		data.TeamID.ValueString(),
 	)
-	if err != nil || apiResp.JSON200 == nil {
-		resp.State.RemoveResource(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to read team external groups, got error: %s", err))
+		return
+	}
+
+	// The team itself is gone, so the mapping is too
+	if apiResp.JSON404 != nil {
+		handleMissingResource(ctx, resp, r.onMissing, "Team external group", data.ID.ValueString())
+		return
+	}
+
+	if apiResp.JSON200 == nil {
+		resp.Diagnostics.AddError(
+			"Unexpected API Response",
+			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
+		)
 		return
 	}
 
@@ -171,7 +195,7 @@ func (r *TeamExternalGroupResource) Read(
 		}
 	}
 
-	resp.State.RemoveResource(ctx)
+	handleMissingResource(ctx, resp, r.onMissing, "Team external group", data.ID.ValueString())
 }
 
 /* ---------------- Update ---------------- */
@@ -191,6 +215,9 @@ func (r *TeamExternalGroupResource) Delete(
 	req resource.DeleteRequest,
 	resp *resource.DeleteResponse,
 ) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
 	var data TeamExternalGroupModel
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
 	if resp.Diagnostics.HasError() {
@@ -211,12 +238,7 @@ func (r *TeamExternalGroupResource) Delete(
 		return
 	}
 
-	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected API Response",
-			fmt.Sprintf("Expected 200 or 404, got %d", apiResp.StatusCode()),
-		)
-	}
+	handleDelete(resp, apiResp)
 }
 
 /* ---------------- Import ---------------- */
@@ -2,9 +2,16 @@ package provider
 
 import (
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"testing"
 
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	frameworkresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
 	"github.com/hashicorp/terraform-plugin-testing/statecheck"
@@ -62,3 +69,70 @@ resource "archestra_team_external_group" "test" {
 }
 `, groupID)
 }
+
+// readTeamExternalGroup runs Read for a mapping against a server that answers
+// the external groups request with status and body.
+func readTeamExternalGroup(t *testing.T, onMissing string, status int, body string) *frameworkresource.ReadResponse {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(status)
+		_, _ = w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	r := &TeamExternalGroupResource{client: apiClient, onMissing: onMissing}
+	schemaResp := &frameworkresource.SchemaResponse{}
+	r.Schema(t.Context(), frameworkresource.SchemaRequest{}, schemaResp)
+
+	objectType := schemaResp.Schema.Type().TerraformType(t.Context()).(tftypes.Object)
+	state := tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"id":                tftypes.NewValue(tftypes.String, "team-1/mapping-1"),
+			"team_id":           tftypes.NewValue(tftypes.String, "team-1"),
+			"external_group_id": tftypes.NewValue(tftypes.String, "engineering"),
+		}),
+	}
+
+	resp := &frameworkresource.ReadResponse{State: state}
+	r.Read(t.Context(), frameworkresource.ReadRequest{State: state}, resp)
+	return resp
+}
+
+func TestTeamExternalGroupRead_Statuses(t *testing.T) {
+	notFound := `{"error":{"message":"Team not found","type":"api_not_found_error"}}`
+	serverError := `{"error":{"message":"boom","type":"api_internal_server_error"}}`
+
+	tests := map[string]struct {
+		onMissing string
+		status    int
+		body      string
+		wantError bool
+		wantKept  bool
+	}{
+		"team not found, remove":    {onMissing: onMissingRemove, status: http.StatusNotFound, body: notFound, wantError: false, wantKept: false},
+		"team not found, error":     {onMissing: onMissingError, status: http.StatusNotFound, body: notFound, wantError: true, wantKept: true},
+		"mapping not found, remove": {onMissing: onMissingRemove, status: http.StatusOK, body: `[]`, wantError: false, wantKept: false},
+		"server error":              {onMissing: onMissingRemove, status: http.StatusInternalServerError, body: serverError, wantError: true, wantKept: true},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := readTeamExternalGroup(t, tt.onMissing, tt.status, tt.body)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("Expected error %t, got diagnostics: %v", tt.wantError, resp.Diagnostics)
+			}
+			if kept := !resp.State.Raw.IsNull(); kept != tt.wantKept {
+				t.Errorf("Expected resource kept in state %t, got %t", tt.wantKept, kept)
+			}
+		})
+	}
+}
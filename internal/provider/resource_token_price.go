@@ -7,9 +7,11 @@ import (
 	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
 	"github.com/google/uuid"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -26,7 +28,9 @@ func NewTokenPriceResource() resource.Resource {
 
 // TokenPriceResource defines the resource implementation.
 type TokenPriceResource struct {
-	client *client.ClientWithResponses
+	client    *client.ClientWithResponses
+	onMissing string
+	timeouts  operationTimeouts
 }
 
 // TokenPriceResourceModel describes the resource data model.
@@ -36,6 +40,7 @@ type TokenPriceResourceModel struct {
 	Model                 types.String `tfsdk:"model"`
 	PricePerMillionInput  types.String `tfsdk:"price_per_million_input"`
 	PricePerMillionOutput types.String `tfsdk:"price_per_million_output"`
+	AdoptExisting         types.Bool   `tfsdk:"adopt_existing"`
 }
 
 func (r *TokenPriceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -73,6 +78,12 @@ func (r *TokenPriceResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Price per million output tokens",
 				Required:            true,
 			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "If true, creating this resource adopts an existing token price for the same `llm_provider` and `model` (updating its prices) instead of failing on the duplicate. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
 		},
 	}
 }
@@ -82,19 +93,24 @@ func (r *TokenPriceResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*client.ClientWithResponses)
+	data, ok := req.ProviderData.(*ArchestraResourceData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *client.ClientWithResponses, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *provider.ArchestraResourceData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = data.Client
+	r.timeouts = data.Timeouts
+	r.onMissing = data.OnMissing
 }
 
 func (r *TokenPriceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Create)
+	defer cancel()
+
 	var data TokenPriceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -103,6 +119,29 @@ func (r *TokenPriceResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	if data.AdoptExisting.ValueBool() {
+		existingID, err := r.findExisting(ctx, data.LLMProvider.ValueString(), data.Model.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to look up existing token prices, got error: %s", err))
+			return
+		}
+
+		if existingID != nil {
+			resp.Diagnostics.AddWarning(
+				"Adopted Existing Token Price",
+				fmt.Sprintf("Adopted existing token price %s for %s/%s. Its prices have been overwritten with the configured values.", existingID, data.LLMProvider.ValueString(), data.Model.ValueString()),
+			)
+			data.ID = types.StringValue(existingID.String())
+			r.update(ctx, *existingID, &data, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			return
+		}
+	}
+
 	requestBody := client.CreateTokenPriceJSONRequestBody{
 		Provider:              client.SupportedProvidersInput(data.LLMProvider.ValueString()),
 		Model:                 data.Model.ValueString(),
@@ -134,6 +173,9 @@ func (r *TokenPriceResource) Create(ctx context.Context, req resource.CreateRequ
 }
 
 func (r *TokenPriceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Read)
+	defer cancel()
+
 	var data TokenPriceResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -155,7 +197,7 @@ func (r *TokenPriceResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	if apiResp.JSON404 != nil {
-		resp.State.RemoveResource(ctx)
+		handleMissingResource(ctx, resp, r.onMissing, "Token price", data.ID.ValueString())
 		return
 	}
 
@@ -176,6 +218,9 @@ func (r *TokenPriceResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *TokenPriceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Update)
+	defer cancel()
+
 	var data TokenPriceResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -190,6 +235,49 @@ func (r *TokenPriceResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	r.update(ctx, id, &data, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TokenPriceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, cancel := withTimeout(ctx, r.timeouts.Delete)
+	defer cancel()
+
+	var data TokenPriceResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := uuid.Parse(data.ID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse token price ID: %s", err))
+		return
+	}
+
+	apiResp, err := r.client.DeleteTokenPriceWithResponse(ctx, id)
+	if err != nil {
+		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to delete token price, got error: %s", err))
+		return
+	}
+
+	handleDelete(resp, apiResp)
+}
+
+func (r *TokenPriceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("adopt_existing"), false)...)
+}
+
+// update pushes the planned prices to the token price with the given ID and
+// maps the response back onto data.
+func (r *TokenPriceResource) update(ctx context.Context, id uuid.UUID, data *TokenPriceResourceModel, diags *diag.Diagnostics) {
 	provider := client.SupportedProvidersInput(data.LLMProvider.ValueString())
 	model := data.Model.ValueString()
 	priceInput := data.PricePerMillionInput.ValueString()
@@ -204,12 +292,12 @@ func (r *TokenPriceResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	apiResp, err := r.client.UpdateTokenPriceWithResponse(ctx, id, requestBody)
 	if err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to update token price, got error: %s", err))
+		diags.AddError("API Error", fmt.Sprintf("Unable to update token price, got error: %s", err))
 		return
 	}
 
 	if apiResp.JSON200 == nil {
-		resp.Diagnostics.AddError(
+		diags.AddError(
 			"Unexpected API Response",
 			fmt.Sprintf("Expected 200 OK, got status %d", apiResp.StatusCode()),
 		)
@@ -220,40 +308,26 @@ func (r *TokenPriceResource) Update(ctx context.Context, req resource.UpdateRequ
 	data.Model = types.StringValue(apiResp.JSON200.Model)
 	data.PricePerMillionInput = types.StringValue(apiResp.JSON200.PricePerMillionInput)
 	data.PricePerMillionOutput = types.StringValue(apiResp.JSON200.PricePerMillionOutput)
-
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
-func (r *TokenPriceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var data TokenPriceResourceModel
-
-	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
-
-	if resp.Diagnostics.HasError() {
-		return
-	}
-
-	id, err := uuid.Parse(data.ID.ValueString())
+// findExisting returns the ID of the token price for the given provider and
+// model, or nil if none exists.
+func (r *TokenPriceResource) findExisting(ctx context.Context, llmProvider, model string) (*uuid.UUID, error) {
+	apiResp, err := r.client.GetTokenPricesWithResponse(ctx)
 	if err != nil {
-		resp.Diagnostics.AddError("Invalid ID", fmt.Sprintf("Unable to parse token price ID: %s", err))
-		return
+		return nil, err
 	}
 
-	apiResp, err := r.client.DeleteTokenPriceWithResponse(ctx, id)
-	if err != nil {
-		resp.Diagnostics.AddError("API Error", fmt.Sprintf("Unable to delete token price, got error: %s", err))
-		return
+	if apiResp.JSON200 == nil {
+		return nil, fmt.Errorf("expected 200 OK, got status %d", apiResp.StatusCode())
 	}
 
-	if apiResp.JSON200 == nil && apiResp.JSON404 == nil {
-		resp.Diagnostics.AddError(
-			"Unexpected API Response",
-			fmt.Sprintf("Expected 200 OK or 404 Not Found, got status %d", apiResp.StatusCode()),
-		)
-		return
+	for _, tp := range *apiResp.JSON200 {
+		if tp.Provider == llmProvider && tp.Model == model {
+			id := tp.Id
+			return &id, nil
+		}
 	}
-}
 
-func (r *TokenPriceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	return nil, nil
 }
@@ -1,11 +1,110 @@
 package provider
 
 import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
+	"github.com/archestra-ai/archestra/terraform-provider-archestra/internal/client"
+	"github.com/google/uuid"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
+const testTokenPriceID = "33333333-3333-3333-3333-333333333333"
+
+func TestTokenPriceFindExisting(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `[{"id":%q,"provider":"anthropic","model":"claude-adopt-test","pricePerMillionInput":"1.00","pricePerMillionOutput":"5.00"}]`,
+			testTokenPriceID)
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+	r := &TokenPriceResource{client: apiClient}
+
+	tests := []struct {
+		name        string
+		llmProvider string
+		model       string
+		expected    string
+	}{
+		{name: "provider and model match", llmProvider: "anthropic", model: "claude-adopt-test", expected: testTokenPriceID},
+		{name: "model matches another provider", llmProvider: "openai", model: "claude-adopt-test"},
+		{name: "other model", llmProvider: "anthropic", model: "claude-other"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := r.findExisting(t.Context(), tt.llmProvider, tt.model)
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			if tt.expected == "" {
+				if id != nil {
+					t.Errorf("Expected no match, got %s", id)
+				}
+				return
+			}
+			if id == nil || id.String() != tt.expected {
+				t.Errorf("Expected match %s, got %v", tt.expected, id)
+			}
+		})
+	}
+}
+
+func TestTokenPriceUpdate_OverwritesPrices(t *testing.T) {
+	var body map[string]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/token-prices/"+testTokenPriceID {
+			t.Errorf("Unexpected request path %s", r.URL.Path)
+		}
+		raw, _ := io.ReadAll(r.Body)
+		if err := json.Unmarshal(raw, &body); err != nil {
+			t.Errorf("Unable to decode request body: %s", err)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = fmt.Fprintf(w, `{"id":%q,"provider":"anthropic","model":"claude-adopt-test","pricePerMillionInput":"2.00","pricePerMillionOutput":"8.00"}`,
+			testTokenPriceID)
+	}))
+	t.Cleanup(server.Close)
+
+	apiClient, err := client.NewClientWithResponses(server.URL)
+	if err != nil {
+		t.Fatalf("Unable to create client: %s", err)
+	}
+
+	r := &TokenPriceResource{client: apiClient}
+	data := TokenPriceResourceModel{
+		LLMProvider:           types.StringValue("anthropic"),
+		Model:                 types.StringValue("claude-adopt-test"),
+		PricePerMillionInput:  types.StringValue("2.00"),
+		PricePerMillionOutput: types.StringValue("8.00"),
+	}
+
+	var diags diag.Diagnostics
+	r.update(t.Context(), uuid.MustParse(testTokenPriceID), &data, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected error: %v", diags)
+	}
+
+	if body["pricePerMillionInput"] != "2.00" || body["pricePerMillionOutput"] != "8.00" {
+		t.Errorf("Expected the configured prices to be sent, got %v", body)
+	}
+	if data.PricePerMillionInput.ValueString() != "2.00" || data.PricePerMillionOutput.ValueString() != "8.00" {
+		t.Errorf("Expected response to be mapped onto data, got %v", data)
+	}
+}
+
 func TestAccTokenPriceResource(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
@@ -35,6 +134,38 @@ func TestAccTokenPriceResource(t *testing.T) {
 	})
 }
 
+func TestAccTokenPriceResourceAdoptExisting(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create the price that will later be adopted
+			{
+				Config: testAccTokenPriceResourceConfig("anthropic", "claude-adopt-test", "1.00", "5.00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("archestra_token_price.test", "id"),
+				),
+			},
+			// A second resource for the same provider/model adopts it instead of failing
+			{
+				Config: testAccTokenPriceResourceConfig("anthropic", "claude-adopt-test", "1.00", "5.00") + `
+resource "archestra_token_price" "adopted" {
+  llm_provider             = "anthropic"
+  model                    = "claude-adopt-test"
+  price_per_million_input  = "1.00"
+  price_per_million_output = "5.00"
+  adopt_existing           = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("archestra_token_price.adopted", "id", "archestra_token_price.test", "id"),
+					resource.TestCheckResourceAttr("archestra_token_price.adopted", "adopt_existing", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccTokenPriceResourceConfig(provider, model, inputPrice, outputPrice string) string {
 	return `
 resource "archestra_token_price" "test" {
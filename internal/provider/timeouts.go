@@ -0,0 +1,67 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// operationTimeouts holds the default timeout of each resource operation, set
+// by the default_*_timeout provider attributes. Zero means no timeout.
+type operationTimeouts struct {
+	Create time.Duration
+	Read   time.Duration
+	Update time.Duration
+	Delete time.Duration
+}
+
+// withTimeout derives the context of a resource operation from the context
+// passed by Terraform. A zero timeout leaves the deadline unchanged.
+func withTimeout(ctx context.Context, timeout time.Duration) (context.Context, context.CancelFunc) {
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// parseTimeout parses a Go duration string that must be positive.
+func parseTimeout(value string) (time.Duration, error) {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, err
+	}
+	if duration <= 0 {
+		return 0, fmt.Errorf("duration %s is not positive", duration)
+	}
+	return duration, nil
+}
+
+var _ validator.String = timeoutValidator{}
+
+// timeoutValidator checks at plan time that a string attribute is a positive
+// Go duration string.
+type timeoutValidator struct{}
+
+func (v timeoutValidator) Description(ctx context.Context) string {
+	return "value must be a positive Go duration string, for example \"10m\" or \"90s\""
+}
+
+func (v timeoutValidator) MarkdownDescription(ctx context.Context) string {
+	return "value must be a positive Go duration string, for example `10m` or `90s`"
+}
+
+func (v timeoutValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := parseTimeout(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Archestra Default Timeout",
+			fmt.Sprintf("The %s value %q must be a positive Go duration string, for example \"10m\" or \"90s\".", req.Path, req.ConfigValue.ValueString()),
+		)
+	}
+}